@@ -0,0 +1,4 @@
+data "podio_app" "crm" {
+  space_id  = 1234
+  url_label = "leads"
+}
@@ -0,0 +1,7 @@
+data "podio_app_fields" "leads" {
+  app_id = 1234
+}
+
+output "lead_status_field_id" {
+  value = data.podio_app_fields.leads.by_external_id["status"].field_id
+}
@@ -0,0 +1,9 @@
+data "podio_app_template" "sandbox_leads" {
+  app_id = 1234
+}
+
+resource "podio_app" "leads" {
+  space_id      = podio_space.production.space_id
+  name          = jsondecode(data.podio_app_template.sandbox_leads.template_json).config.name
+  template_json = data.podio_app_template.sandbox_leads.template_json
+}
@@ -0,0 +1,12 @@
+data "podio_apps" "all" {
+  space_id = 1234
+}
+
+resource "podio_hook" "item_create" {
+  for_each = { for app in data.podio_apps.all.apps : app.url_label => app.app_id }
+
+  ref_type = "app"
+  ref_id   = each.value
+  url      = "https://example.com/podio/hook"
+  type     = "item.create"
+}
@@ -0,0 +1,11 @@
+data "podio_icon_search" "kanban" {
+  query = "kanban"
+  limit = 1
+}
+
+resource "podio_app" "kanban" {
+  name      = "Kanban"
+  space_id  = podio_space.kanban_board.space_id
+  item_name = "Task"
+  icon      = data.podio_icon_search.kanban.icons[0]
+}
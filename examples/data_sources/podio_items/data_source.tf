@@ -0,0 +1,15 @@
+data "podio_items" "open_emea_tickets" {
+  app_id = 1234
+
+  category_filters = {
+    status = ["Open"]
+    region = ["EMEA"]
+  }
+
+  date_filters = {
+    due = {
+      from = "2022-01-01"
+      to   = "2022-12-31"
+    }
+  }
+}
@@ -1,3 +1,10 @@
 data "podio_organization" "my-org" {
   url_label = "citrix"
-}
\ No newline at end of file
+}
+data "podio_organization" "by-name" {
+  name = "Citrix Systems"
+}
+
+data "podio_organization" "by-name-regex" {
+  name_regex = "^Citrix"
+}
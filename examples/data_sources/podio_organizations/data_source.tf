@@ -0,0 +1,7 @@
+data "podio_organizations" "all" {
+  include_spaces = true
+}
+
+output "org_ids" {
+  value = { for org in data.podio_organizations.all.organizations : org.url_label => org.org_id }
+}
@@ -15,6 +15,22 @@ resource "podio_app" "kanban" {
   usage       = "Backlog is open, do stuff, and mark as done!"
   item_name   = "Task"
   icon        = "22.png"
+
+  field {
+    type        = "text"
+    label       = "Title"
+    external_id = "title"
+    required    = true
+  }
+
+  field {
+    type        = "text"
+    label       = "Details"
+    external_id = "details"
+    config = jsonencode({
+      size = "large"
+    })
+  }
 }
 
 output "space_url" {
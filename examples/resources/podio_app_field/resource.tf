@@ -0,0 +1,30 @@
+resource "podio_app_field" "status" {
+  app_id   = podio_app.kanban.app_id
+  type     = "category"
+  label    = "Status"
+  display  = "inline"
+  multiple = false
+
+  option {
+    text  = "Backlog"
+    color = "DCEBD8"
+  }
+
+  option {
+    text  = "In Progress"
+    color = "FFD5C2"
+  }
+
+  option {
+    text  = "Done"
+    color = "D2E4EB"
+  }
+}
+
+resource "podio_app_field" "customer" {
+  app_id             = podio_app.kanban.app_id
+  type               = "app"
+  label              = "Customer"
+  multiple           = false
+  referenced_app_ids = [podio_app.customers.app_id]
+}
@@ -0,0 +1,13 @@
+resource "podio_app_form" "signup" {
+  app_id       = podio_app.leads.app_id
+  external_ids = ["name", "email", "company"]
+
+  domains           = ["example.com", "www.example.com"]
+  success_message   = "Thanks, we'll be in touch!"
+  allow_attachments = false
+  captcha           = true
+}
+
+output "signup_embed_code" {
+  value = podio_app_form.signup.embed_code
+}
@@ -0,0 +1,9 @@
+resource "podio_app_from_template" "crm" {
+  space_id = podio_space.sales.space_id
+  share_id = 12345
+}
+
+resource "podio_app_from_template" "leads_copy" {
+  space_id      = podio_space.sales.space_id
+  source_app_id = 67890
+}
@@ -0,0 +1,7 @@
+resource "podio_grant" "client_tickets" {
+  ref_type = "app"
+  ref_id   = podio_app.tickets.app_id
+  email    = "client@example.com"
+  action   = "comment"
+  message  = "You can now follow your tickets in Podio."
+}
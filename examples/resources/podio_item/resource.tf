@@ -0,0 +1,19 @@
+resource "podio_item" "office_berlin" {
+  app_id      = podio_app.offices.app_id
+  external_id = "office-berlin"
+
+  fields = {
+    name = {
+      text = "Berlin"
+    }
+    region = {
+      options = ["EMEA"]
+    }
+    headcount = {
+      number = 42
+    }
+    opened = {
+      start = "2019-04-01"
+    }
+  }
+}
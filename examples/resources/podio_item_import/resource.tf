@@ -0,0 +1,11 @@
+resource "podio_item_import" "offices" {
+  app_id             = podio_app.offices.app_id
+  path               = "${path.module}/offices.csv"
+  external_id_column = "Code"
+
+  columns = {
+    "Name"      = "name"
+    "Region"    = "region"
+    "Headcount" = "headcount"
+  }
+}
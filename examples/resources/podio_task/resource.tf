@@ -0,0 +1,12 @@
+resource "podio_task" "kickoff" {
+  text        = "Schedule the project kickoff"
+  description = "Invite the client and the delivery team."
+  due_date    = "2022-06-01"
+  due_time    = "10:00"
+  labels      = ["onboarding"]
+
+  ref_type = "space"
+  ref_id   = podio_space.project.space_id
+
+  reopen_on_drift = true
+}
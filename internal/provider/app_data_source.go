@@ -0,0 +1,424 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kayteh/podio-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = appDataSourceType{}
+var _ tfsdk.DataSource = appDataSource{}
+
+type appDataSourceType struct{}
+
+func (t appDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "An app within a space in Podio",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"app_id": {
+				Type:        types.Int64Type,
+				Description: "The numeric ID of the app. Mutually exclusive with `space_id` and `url_label`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"space_id": {
+				Type:        types.Int64Type,
+				Description: "The numeric ID of the space the app is in. Must be set together with `url_label`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"url_label": {
+				Type:        types.StringType,
+				Description: "The URL label/slug of the app within its space. Must be set together with `space_id`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"url": {
+				Description: "URL of the app",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"status": {
+				Description: "Status of the app, either `active` or `inactive`",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Name of the app",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"type": {
+				Description: "Type of the app",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"item_name": {
+				Description: "Name of the item type of the app",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"description": {
+				Description: "Description of the app",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"usage": {
+				Description: "How the app should be used",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"icon": {
+				Description: "Icon of the app",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"external_id": {
+				Description: "External ID of the app",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"allow_edit": {
+				Description: "True if the app is editable",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"allow_attachments": {
+				Description: "True if attachment of files to an item is allowed",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"allow_comments": {
+				Description: "True if comments are allowed",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"allow_create": {
+				Description: "True if new items can be created",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"allow_tags": {
+				Description: "True if tagging of items is allowed",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"silent_creates": {
+				Description: "True if item creates are not posted to the stream",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"silent_edits": {
+				Description: "True if item edits are not posted to the stream",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"default_view": {
+				Description: "The default view of the app items on the app main page",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"disable_notifications": {
+				Description: "True if notifications are not sent for changes to items in this app",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"fivestar": {
+				Description: "True if fivestar rating is enabled on an item",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"fivestar_label": {
+				Description: "Label of the fivestar rating",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"thumbs": {
+				Description: "True if thumbs ratings are enabled on an item",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"thumbs_label": {
+				Description: "Label of the thumbs rating",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"approved": {
+				Description: "True if an item can be approved",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"rsvp": {
+				Description: "True if RSVP is enabled on an item",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"rsvp_label": {
+				Description: "Label of the RSVP",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"yesno": {
+				Description: "True if yes/no rating is enabled on an item",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"yesno_label": {
+				Description: "Label of the yes/no rating",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"tasks": {
+				Description: "Tasks that are created for every new item in the app",
+				Type:        types.ListType{ElemType: types.StringType},
+				Computed:    true,
+			},
+			"show_app_item_id": {
+				Description: "True if the app item ID is shown on items",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"app_item_id_prefix": {
+				Description: "Prefix shown in front of the app item ID",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"calendar_color_category_field": {
+				Description: "ID of the category field used to color items in the calendar view",
+				Type:        types.Int64Type,
+				Computed:    true,
+			},
+			"fields": {
+				Description: "Fields of the app, in the order they appear",
+				Computed:    true,
+				Attributes:  tfsdk.ListNestedAttributes(appFieldListAttributes(), tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t appDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return appDataSource{
+		provider: provider,
+	}, diags
+}
+
+type appDataSourceData struct {
+	AppID                      types.Int64        `tfsdk:"app_id"`
+	SpaceID                    types.Int64        `tfsdk:"space_id"`
+	URLLabel                   types.String       `tfsdk:"url_label"`
+	URL                        types.String       `tfsdk:"url"`
+	Status                     types.String       `tfsdk:"status"`
+	Name                       types.String       `tfsdk:"name"`
+	Type                       types.String       `tfsdk:"type"`
+	ItemName                   types.String       `tfsdk:"item_name"`
+	Description                types.String       `tfsdk:"description"`
+	Usage                      types.String       `tfsdk:"usage"`
+	Icon                       types.String       `tfsdk:"icon"`
+	ExternalID                 types.String       `tfsdk:"external_id"`
+	AllowEdit                  types.Bool         `tfsdk:"allow_edit"`
+	AllowAttachments           types.Bool         `tfsdk:"allow_attachments"`
+	AllowComments              types.Bool         `tfsdk:"allow_comments"`
+	AllowCreate                types.Bool         `tfsdk:"allow_create"`
+	AllowTags                  types.Bool         `tfsdk:"allow_tags"`
+	SilentCreates              types.Bool         `tfsdk:"silent_creates"`
+	SilentEdits                types.Bool         `tfsdk:"silent_edits"`
+	DefaultView                types.String       `tfsdk:"default_view"`
+	DisableNotifications       types.Bool         `tfsdk:"disable_notifications"`
+	Fivestar                   types.Bool         `tfsdk:"fivestar"`
+	FivestarLabel              types.String       `tfsdk:"fivestar_label"`
+	Thumbs                     types.Bool         `tfsdk:"thumbs"`
+	ThumbsLabel                types.String       `tfsdk:"thumbs_label"`
+	Approved                   types.Bool         `tfsdk:"approved"`
+	RSVP                       types.Bool         `tfsdk:"rsvp"`
+	RSVPLabel                  types.String       `tfsdk:"rsvp_label"`
+	YesNo                      types.Bool         `tfsdk:"yesno"`
+	YesNoLabel                 types.String       `tfsdk:"yesno_label"`
+	Tasks                      types.List         `tfsdk:"tasks"`
+	ShowAppItemID              types.Bool         `tfsdk:"show_app_item_id"`
+	AppItemIDPrefix            types.String       `tfsdk:"app_item_id_prefix"`
+	CalendarColorCategoryField types.Int64        `tfsdk:"calendar_color_category_field"`
+	Fields                     []appFieldListData `tfsdk:"fields"`
+}
+
+func (data *appDataSourceData) setApp(app *podio.App) diag.Diagnostics {
+	data.AppID = types.Int64{Value: int64(app.AppID)}
+	data.SpaceID = types.Int64{Value: int64(app.SpaceID)}
+	data.URLLabel = types.String{Value: app.URLLabel}
+	data.URL = types.String{Value: app.Link}
+	data.Status = types.String{Value: app.Status}
+
+	config := newAppConfigData(app.Config)
+	data.Name = config.Name
+	data.Type = config.Type
+	data.ItemName = config.ItemName
+	data.Description = config.Description
+	data.Usage = config.Usage
+	data.Icon = config.Icon
+	data.ExternalID = config.ExternalID
+	data.AllowEdit = config.AllowEdit
+	data.AllowAttachments = config.AllowAttachments
+	data.AllowComments = config.AllowComments
+	data.AllowCreate = config.AllowCreate
+	data.AllowTags = config.AllowTags
+	data.SilentCreates = config.SilentCreates
+	data.SilentEdits = config.SilentEdits
+	data.DefaultView = config.DefaultView
+	data.DisableNotifications = config.DisableNotifications
+	data.Fivestar = config.Fivestar
+	data.FivestarLabel = config.FivestarLabel
+	data.Thumbs = config.Thumbs
+	data.ThumbsLabel = config.ThumbsLabel
+	data.Approved = config.Approved
+	data.RSVP = config.RSVP
+	data.RSVPLabel = config.RSVPLabel
+	data.YesNo = config.YesNo
+	data.YesNoLabel = config.YesNoLabel
+	data.Tasks = config.Tasks
+	data.ShowAppItemID = config.ShowAppItemID
+	data.AppItemIDPrefix = config.AppItemIDPrefix
+	data.CalendarColorCategoryField = config.CalendarColorCategoryField
+
+	fields, diags := newAppFieldListData(sortedAppFields(app.Fields))
+	data.Fields = fields
+
+	return diags
+}
+
+type appDataSource struct {
+	provider provider
+}
+
+func (d appDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data appDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app := &podio.App{}
+	var err error
+
+	// Error if `app_id` is set together with `space_id` or `url_label`
+	if !data.AppID.Null && (!data.SpaceID.Null || !data.URLLabel.Null) {
+		resp.Diagnostics.AddError("Ambiguous search pattern", "Only set one of `app_id` or `space_id` and `url_label`, not both.")
+		return
+	}
+
+	if !data.AppID.Null {
+		app, err = d.provider.client.GetApplication(fmt.Sprintf("%d", data.AppID.Value))
+	} else if !data.SpaceID.Null && !data.URLLabel.Null {
+		app, err = d.provider.client.GetApplicationByLabel(fmt.Sprintf("%d", data.SpaceID.Value), data.URLLabel.Value)
+	} else {
+		resp.Diagnostics.AddError("No app specified", "Either `app_id` or both `space_id` and `url_label` must be specified")
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching app", fmt.Sprintf("Unable to fetch app, got error: %s", err))
+		return
+	}
+
+	diags = data.setApp(app)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// appFieldListAttributes are the attributes of the fields listed by data
+// sources.
+func appFieldListAttributes() map[string]tfsdk.Attribute {
+	return map[string]tfsdk.Attribute{
+		"field_id": {
+			Description: "ID of the field",
+			Type:        types.Int64Type,
+			Computed:    true,
+		},
+		"external_id": {
+			Description: "External ID of the field",
+			Type:        types.StringType,
+			Computed:    true,
+		},
+		"label": {
+			Description: "Label of the field",
+			Type:        types.StringType,
+			Computed:    true,
+		},
+		"type": {
+			Description: "Type of the field",
+			Type:        types.StringType,
+			Computed:    true,
+		},
+		"status": {
+			Description: "Status of the field, either `active` or `deleted`",
+			Type:        types.StringType,
+			Computed:    true,
+		},
+		"description": {
+			Description: "Description of the field",
+			Type:        types.StringType,
+			Computed:    true,
+		},
+		"required": {
+			Description: "True if the field is required",
+			Type:        types.BoolType,
+			Computed:    true,
+		},
+		"config": {
+			Description: "JSON encoded settings of the field, which depend on its type",
+			Type:        types.StringType,
+			Computed:    true,
+		},
+	}
+}
+
+type appFieldListData struct {
+	FieldID     types.Int64  `tfsdk:"field_id"`
+	ExternalID  types.String `tfsdk:"external_id"`
+	Label       types.String `tfsdk:"label"`
+	Type        types.String `tfsdk:"type"`
+	Status      types.String `tfsdk:"status"`
+	Description types.String `tfsdk:"description"`
+	Required    types.Bool   `tfsdk:"required"`
+	Config      types.String `tfsdk:"config"`
+}
+
+// newAppFieldListData converts fields returned by Podio for data sources.
+func newAppFieldListData(fields []podio.AppField) ([]appFieldListData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	list := []appFieldListData{}
+	for _, field := range fields {
+		config, err := json.Marshal(field.Config.Settings)
+		if err != nil {
+			diags.AddError("Invalid field config", fmt.Sprintf("Unable to encode config of field %d: %s", field.FieldID, err))
+			continue
+		}
+
+		list = append(list, appFieldListData{
+			FieldID:     types.Int64{Value: int64(field.FieldID)},
+			ExternalID:  types.String{Value: field.ExternalID},
+			Label:       types.String{Value: field.Config.Label},
+			Type:        types.String{Value: field.Type},
+			Status:      types.String{Value: field.Status},
+			Description: types.String{Value: field.Config.Description},
+			Required:    types.Bool{Value: field.Config.Required},
+			Config:      types.String{Value: string(config)},
+		})
+	}
+
+	return list, diags
+}
@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kayteh/podio-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = appFieldOrderResourceType{}
+var _ tfsdk.Resource = appFieldOrderResource{}
+var _ tfsdk.ResourceWithValidateConfig = appFieldOrderResource{}
+
+type appFieldOrderResourceType struct{}
+
+func (t appFieldOrderResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "The order of the fields within an app. The listed fields are placed first, in the given order, followed by any other fields in their current order. Moving any other field above or in between the listed fields is detected as drift. Destroying this resource leaves the fields where they are.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"app_id": {
+				MarkdownDescription: "ID of the app",
+				Type:                types.Int64Type,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"field_ids": {
+				MarkdownDescription: "IDs of the fields in the order they should appear. Mutually exclusive with `external_ids`.",
+				Type:                types.ListType{ElemType: types.Int64Type},
+				Optional:            true,
+			},
+			"external_ids": {
+				MarkdownDescription: "External IDs of the fields in the order they should appear. Mutually exclusive with `field_ids`.",
+				Type:                types.ListType{ElemType: types.StringType},
+				Optional:            true,
+			},
+		},
+	}, nil
+}
+
+func (t appFieldOrderResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return appFieldOrderResource{
+		provider: provider,
+	}, diags
+}
+
+type appFieldOrderResourceData struct {
+	AppID       types.Int64 `tfsdk:"app_id"`
+	FieldIDs    types.List  `tfsdk:"field_ids"`
+	ExternalIDs types.List  `tfsdk:"external_ids"`
+}
+
+// setOrder sets the managed fields to the fields that currently occupy their
+// positions at the top of the app, dropping fields that no longer exist. This
+// surfaces fields being moved in the UI, including other fields being moved in
+// between the managed ones.
+func (data *appFieldOrderResourceData) setOrder(ctx context.Context, fields []podio.AppField) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fields = sortedAppFields(fields)
+
+	if data.FieldIDs.Null && data.ExternalIDs.Null {
+		data.FieldIDs = types.List{ElemType: types.Int64Type, Elems: []attr.Value{}}
+		for _, field := range fields {
+			data.FieldIDs.Elems = append(data.FieldIDs.Elems, types.Int64{Value: int64(field.FieldID)})
+		}
+
+		return diags
+	}
+
+	if !data.FieldIDs.Null {
+		var fieldIDs []int64
+		diags.Append(data.FieldIDs.ElementsAs(ctx, &fieldIDs, false)...)
+
+		managed := map[int64]bool{}
+		for _, fieldID := range fieldIDs {
+			managed[fieldID] = true
+		}
+
+		data.FieldIDs = types.List{ElemType: types.Int64Type, Elems: []attr.Value{}}
+		for _, field := range leadingAppFields(fields, func(field podio.AppField) bool { return managed[int64(field.FieldID)] }) {
+			data.FieldIDs.Elems = append(data.FieldIDs.Elems, types.Int64{Value: int64(field.FieldID)})
+		}
+	}
+
+	if !data.ExternalIDs.Null {
+		var externalIDs []string
+		diags.Append(data.ExternalIDs.ElementsAs(ctx, &externalIDs, false)...)
+
+		managed := map[string]bool{}
+		for _, externalID := range externalIDs {
+			managed[externalID] = true
+		}
+
+		data.ExternalIDs = types.List{ElemType: types.StringType, Elems: []attr.Value{}}
+		for _, field := range leadingAppFields(fields, func(field podio.AppField) bool { return managed[field.ExternalID] }) {
+			data.ExternalIDs.Elems = append(data.ExternalIDs.Elems, types.String{Value: field.ExternalID})
+		}
+	}
+
+	return diags
+}
+
+// leadingAppFields returns as many fields from the top of the sorted fields as
+// there are managed fields in the app. When the managed fields are in place,
+// these are exactly the managed fields in their current order.
+func leadingAppFields(fields []podio.AppField, managed func(podio.AppField) bool) []podio.AppField {
+	count := 0
+	for _, field := range fields {
+		if managed(field) {
+			count++
+		}
+	}
+
+	return fields[:count]
+}
+
+type appFieldOrderResource struct {
+	provider provider
+}
+
+func (r appFieldOrderResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var data appFieldOrderResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.FieldIDs.Null && !data.ExternalIDs.Null {
+		resp.Diagnostics.AddError("Ambiguous field order", "Only set one of `field_ids` or `external_ids`, not both.")
+		return
+	}
+
+	if data.FieldIDs.Null && data.ExternalIDs.Null {
+		resp.Diagnostics.AddError("No field order specified", "Either `field_ids` or `external_ids` must be specified")
+	}
+}
+
+// reorder moves the planned fields to the top of the app in the planned
+// order, followed by the other fields in their current order.
+func (r appFieldOrderResource) reorder(ctx context.Context, data appFieldOrderResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	appID := strconv.Itoa(int(data.AppID.Value))
+
+	app, err := r.provider.client.GetApplication(appID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return diags
+	}
+
+	fields := sortedAppFields(app.Fields)
+
+	byExternalID := map[string]int{}
+	exists := map[int]bool{}
+	for _, field := range fields {
+		byExternalID[field.ExternalID] = field.FieldID
+		exists[field.FieldID] = true
+	}
+
+	order := []int{}
+
+	if !data.FieldIDs.Null {
+		var fieldIDs []int64
+		diags.Append(data.FieldIDs.ElementsAs(ctx, &fieldIDs, false)...)
+
+		for _, fieldID := range fieldIDs {
+			if !exists[int(fieldID)] {
+				diags.AddError("Unknown field", fmt.Sprintf("Field %d doesn't exist in app %s", fieldID, appID))
+				continue
+			}
+
+			order = append(order, int(fieldID))
+		}
+	} else {
+		var externalIDs []string
+		diags.Append(data.ExternalIDs.ElementsAs(ctx, &externalIDs, false)...)
+
+		for _, externalID := range externalIDs {
+			fieldID, ok := byExternalID[externalID]
+			if !ok {
+				diags.AddError("Unknown field", fmt.Sprintf("Field %q doesn't exist in app %s", externalID, appID))
+				continue
+			}
+
+			order = append(order, fieldID)
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	ordered := map[int]bool{}
+	for _, fieldID := range order {
+		ordered[fieldID] = true
+	}
+
+	for _, field := range fields {
+		if !ordered[field.FieldID] {
+			order = append(order, field.FieldID)
+		}
+	}
+
+	err = r.provider.client.UpdateAppFieldOrder(appID, order)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update field order: %s", err))
+	}
+
+	return diags
+}
+
+func (r appFieldOrderResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data appFieldOrderResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.reorder(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "ordered the fields of an app in Podio")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r appFieldOrderResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data appFieldOrderResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := r.provider.client.GetApplication(strconv.Itoa(int(data.AppID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return
+	}
+
+	diags = data.setOrder(ctx, app.Fields)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r appFieldOrderResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data appFieldOrderResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.reorder(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r appFieldOrderResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	// Podio always has a field order, so there is nothing to delete.
+	resp.State.RemoveResource(ctx)
+}
+
+func (r appFieldOrderResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	appID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Unable to parse app_id: %s", err))
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("app_id"), appID)
+	resp.Diagnostics.Append(diags...)
+}
+
+// sortedAppFields returns the fields that aren't deleted, in the order they
+// appear in the app.
+func sortedAppFields(fields []podio.AppField) []podio.AppField {
+	sorted := []podio.AppField{}
+	for _, field := range fields {
+		if field.Status != "deleted" {
+			sorted = append(sorted, field)
+		}
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Config.Delta < sorted[j].Config.Delta
+	})
+
+	return sorted
+}
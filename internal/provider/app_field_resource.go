@@ -2,17 +2,33 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kayteh/podio-go"
+	"github.com/kayteh/terraform-provider-podio/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ tfsdk.ResourceType = appFieldResourceType{}
 var _ tfsdk.Resource = appField{}
+var _ tfsdk.ResourceWithValidateConfig = appField{}
+var _ tfsdk.ResourceWithModifyPlan = appField{}
+
+// appFieldTypes are the field types that can be created within an app.
+var appFieldTypes = []string{
+	"text", "number", "image", "date", "app", "money", "progress", "location",
+	"duration", "contact", "calculation", "embed", "question", "category", "tel", "email",
+}
 
 type appFieldResourceType struct{}
 
@@ -20,7 +36,161 @@ func (t appFieldResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag
 	return tfsdk.Schema{
 		MarkdownDescription: "A field within an app",
 
-		Attributes: map[string]tfsdk.Attribute{},
+		Attributes: map[string]tfsdk.Attribute{
+			"app_id": {
+				MarkdownDescription: "ID of the app",
+				Type:                types.Int64Type,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"field_id": {
+				MarkdownDescription: "ID of the field",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"type": {
+				MarkdownDescription: "Type of the field. Changing this deletes the field and all of its values before creating it again.",
+				Type:                types.StringType,
+				Required:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSliceValidator(appFieldTypes),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"label": {
+				MarkdownDescription: "Label of the field",
+				Type:                types.StringType,
+				Required:            true,
+			},
+			"external_id": {
+				MarkdownDescription: "External ID of the field. Generated from the label by Podio if not set.",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"description": {
+				MarkdownDescription: "Description of the field",
+				Type:                types.StringType,
+				Optional:            true,
+			},
+			"required": {
+				MarkdownDescription: "True if the field is required when creating and editing items",
+				Type:                types.BoolType,
+				Optional:            true,
+			},
+			"config": {
+				MarkdownDescription: "JSON encoded settings of the field, which depend on its type. Only the settings given here are compared against Podio. Settings with a dedicated attribute, like category `option` blocks, must not be set here.",
+				Type:                types.StringType,
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringIsJSONValidator{},
+				},
+			},
+			"multiple": {
+				MarkdownDescription: "True if more than one option or item can be selected. Only valid for `category` and `app` fields.",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+			},
+			"display": {
+				MarkdownDescription: "How the options are displayed. One of: `inline`, `list`, `dropdown`. Only valid for `category` fields.",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSliceValidator{"inline", "list", "dropdown"},
+				},
+			},
+			"referenced_app_ids": {
+				MarkdownDescription: "IDs of the apps whose items can be referenced. Only valid for `app` fields. Both apps must exist before the field can be created, so apps referencing each other should use `podio_app_field` rather than inline `field` blocks.",
+				Type:                types.SetType{ElemType: types.Int64Type},
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"referenced_app_views": {
+				MarkdownDescription: "IDs of the views used to pick items, keyed by the ID of the referenced app. Only valid for `app` fields.",
+				Type:                types.MapType{ElemType: types.Int64Type},
+				Optional:            true,
+			},
+			"allow_data_loss": {
+				MarkdownDescription: "Deleting a field deletes its values from every item. Unless this is `true`, destroying or replacing a field that has values on any item fails. Must be applied before the field is destroyed to take effect.",
+				Type:                types.BoolType,
+				Optional:            true,
+			},
+			"script": {
+				MarkdownDescription: "Script of the calculation, referencing other fields as `@[Label](field_1234)`. The script is parsed and the referenced fields are checked to exist in the app at plan time. Only valid for `calculation` fields.",
+				Type:                types.StringType,
+				Optional:            true,
+			},
+			"unit": {
+				MarkdownDescription: "Unit shown next to the result of the calculation. Only valid for `calculation` fields.",
+				Type:                types.StringType,
+				Optional:            true,
+			},
+			"decimals": {
+				MarkdownDescription: "Number of decimals shown for the result of the calculation. Only valid for `calculation` fields.",
+				Type:                types.Int64Type,
+				Optional:            true,
+			},
+			"return_type": {
+				MarkdownDescription: "Type of the result of the calculation, one of: `number`, `date` or `text`. Only valid for `calculation` fields.",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSliceValidator{"number", "date", "text"},
+				},
+			},
+		},
+
+		Blocks: map[string]tfsdk.Block{
+			"option": {
+				MarkdownDescription: "An option of a `category` field. Options are matched by `id`, then by `text`, and any other option is created as a new one. To rename an option and keep the values of existing items, set its `id`. Removed options are marked as deleted instead of being removed.",
+				NestingMode:         tfsdk.BlockNestingModeList,
+				Attributes: map[string]tfsdk.Attribute{
+					"id": {
+						MarkdownDescription: "ID of the option. Taken from the option with the same `text` if not set.",
+						Type:                types.Int64Type,
+						Optional:            true,
+						Computed:            true,
+					},
+					"text": {
+						MarkdownDescription: "Text of the option",
+						Type:                types.StringType,
+						Required:            true,
+					},
+					"color": {
+						MarkdownDescription: "Color of the option as a hex code without the `#`, e.g. `DCEBD8`",
+						Type:                types.StringType,
+						Optional:            true,
+						Computed:            true,
+					},
+					"status": {
+						MarkdownDescription: "Status of the option, one of: `active` or `deleted`. Defaults to `active`.",
+						Type:                types.StringType,
+						Optional:            true,
+						Computed:            true,
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSliceValidator{"active", "deleted"},
+						},
+					},
+				},
+			},
+		},
 	}, nil
 }
 
@@ -33,13 +203,436 @@ func (t appFieldResourceType) NewResource(ctx context.Context, in tfsdk.Provider
 }
 
 type appFieldData struct {
-	SpaceID types.Int64 `tfsdk:"space_id"`
+	AppID       types.Int64  `tfsdk:"app_id"`
+	FieldID     types.Int64  `tfsdk:"field_id"`
+	Type        types.String `tfsdk:"type"`
+	Label       types.String `tfsdk:"label"`
+	ExternalID  types.String `tfsdk:"external_id"`
+	Description types.String `tfsdk:"description"`
+	Required    types.Bool   `tfsdk:"required"`
+	Config      types.String `tfsdk:"config"`
+	Multiple    types.Bool   `tfsdk:"multiple"`
+	Display     types.String `tfsdk:"display"`
+
+	ReferencedAppIDs   types.Set `tfsdk:"referenced_app_ids"`
+	ReferencedAppViews types.Map `tfsdk:"referenced_app_views"`
+
+	AllowDataLoss types.Bool `tfsdk:"allow_data_loss"`
+
+	Script     types.String `tfsdk:"script"`
+	Unit       types.String `tfsdk:"unit"`
+	Decimals   types.Int64  `tfsdk:"decimals"`
+	ReturnType types.String `tfsdk:"return_type"`
+
+	Options []appFieldOptionData `tfsdk:"option"`
+}
+
+type appFieldOptionData struct {
+	ID     types.Int64  `tfsdk:"id"`
+	Text   types.String `tfsdk:"text"`
+	Color  types.String `tfsdk:"color"`
+	Status types.String `tfsdk:"status"`
+}
+
+// fieldConfig builds the Podio field config from the Terraform data. Category
+// options must already be matched with matchCategoryOptions.
+func (data appFieldData) fieldConfig(ctx context.Context) (podio.AppFieldConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	settings, err := appFieldSettings(data.Config)
+	if err != nil {
+		diags.AddError("Invalid field config", fmt.Sprintf("Unable to decode config: %s", err))
+		return podio.AppFieldConfig{}, diags
+	}
+
+	if data.Type.Value == "category" {
+		options := []interface{}{}
+		for _, option := range data.Options {
+			value := map[string]interface{}{
+				"text":   option.Text.Value,
+				"status": "active",
+			}
+
+			if !option.ID.Null && !option.ID.Unknown {
+				value["id"] = option.ID.Value
+			}
+
+			if !option.Color.Null && !option.Color.Unknown {
+				value["color"] = option.Color.Value
+			}
+
+			if !option.Status.Null && !option.Status.Unknown {
+				value["status"] = option.Status.Value
+			}
+
+			options = append(options, value)
+		}
+
+		settings["options"] = options
+
+		if !data.Multiple.Null && !data.Multiple.Unknown {
+			settings["multiple"] = data.Multiple.Value
+		}
+
+		if !data.Display.Null && !data.Display.Unknown {
+			settings["display"] = data.Display.Value
+		}
+	}
+
+	// The references are only sent when known, as sending an empty list
+	// clears them.
+	if data.Type.Value == "app" && !data.ReferencedAppIDs.Null && !data.ReferencedAppIDs.Unknown {
+		var appIDs []int64
+		diags.Append(data.ReferencedAppIDs.ElementsAs(ctx, &appIDs, false)...)
+
+		views := map[string]int64{}
+		diags.Append(data.ReferencedAppViews.ElementsAs(ctx, &views, true)...)
+
+		referenced := []interface{}{}
+		for _, appID := range appIDs {
+			value := map[string]interface{}{
+				"app_id": appID,
+			}
+
+			if viewID, ok := views[strconv.FormatInt(appID, 10)]; ok {
+				value["view_id"] = viewID
+			}
+
+			referenced = append(referenced, value)
+		}
+
+		settings["referenced_apps"] = referenced
+	}
+
+	if data.Type.Value == "app" {
+		if !data.Multiple.Null && !data.Multiple.Unknown {
+			settings["multiple"] = data.Multiple.Value
+		}
+	}
+
+	if data.Type.Value == "calculation" {
+		settings["script"] = data.Script.Value
+
+		if !data.Unit.Null {
+			settings["unit"] = data.Unit.Value
+		}
+
+		if !data.Decimals.Null {
+			settings["decimals"] = data.Decimals.Value
+		}
+
+		if !data.ReturnType.Null && !data.ReturnType.Unknown {
+			settings["return_type"] = data.ReturnType.Value
+		}
+	}
+
+	return podio.AppFieldConfig{
+		Label:       data.Label.Value,
+		Description: data.Description.Value,
+		Required:    data.Required.Value,
+		Settings:    settings,
+	}, diags
+}
+
+// setField copies the field returned by Podio into the Terraform data.
+func (data *appFieldData) setField(field *podio.AppField) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.FieldID = types.Int64{Value: int64(field.FieldID)}
+	data.Type = types.String{Value: field.Type}
+	data.Label = types.String{Value: field.Config.Label}
+	data.ExternalID = types.String{Value: field.ExternalID}
+
+	if !data.Description.Null || field.Config.Description != "" {
+		data.Description = types.String{Value: field.Config.Description}
+	}
+
+	if !data.Required.Null || field.Config.Required {
+		data.Required = types.Bool{Value: field.Config.Required}
+	}
+
+	config, err := appFieldSettingsValue(data.Config, field.Config.Settings)
+	if err != nil {
+		diags.AddError("Invalid field config", fmt.Sprintf("Unable to encode config: %s", err))
+	}
+	data.Config = config
+
+	data.Multiple = types.Bool{Null: true}
+	data.Display = types.String{Null: true}
+	data.ReferencedAppIDs = types.Set{ElemType: types.Int64Type, Null: true}
+
+	if field.Type == "app" {
+		data.setReferencedApps(field)
+	}
+
+	if field.Type == "calculation" {
+		data.setCalculation(field)
+	} else {
+		data.ReturnType = types.String{Null: true}
+	}
+
+	if field.Type != "category" {
+		data.Options = []appFieldOptionData{}
+		return diags
+	}
+
+	multiple, _ := field.Config.Settings["multiple"].(bool)
+	display, _ := field.Config.Settings["display"].(string)
+
+	data.Multiple = types.Bool{Value: multiple}
+	data.Display = types.String{Value: display}
+
+	remote := categoryOptions(field.Config.Settings)
+	used := map[int64]bool{}
+	options := []appFieldOptionData{}
+
+	for _, option := range data.Options {
+		var match *categoryOption
+
+		for i := range remote {
+			if used[remote[i].ID] {
+				continue
+			}
+
+			if !option.ID.Null && !option.ID.Unknown {
+				if remote[i].ID == option.ID.Value {
+					match = &remote[i]
+					break
+				}
+			} else if remote[i].Text == option.Text.Value {
+				match = &remote[i]
+				break
+			}
+		}
+
+		if match == nil {
+			continue
+		}
+
+		used[match.ID] = true
+		options = append(options, match.data())
+	}
+
+	data.Options = options
+
+	return diags
+}
+
+// setReferencedApps copies the referenced apps of an app field into the
+// Terraform data. Views are only tracked when they were configured.
+func (data *appFieldData) setReferencedApps(field *podio.AppField) {
+	multiple, _ := field.Config.Settings["multiple"].(bool)
+	data.Multiple = types.Bool{Value: multiple}
+
+	data.ReferencedAppIDs = types.Set{ElemType: types.Int64Type, Elems: []attr.Value{}}
+	views := types.Map{ElemType: types.Int64Type, Elems: map[string]attr.Value{}}
+
+	raw, _ := field.Config.Settings["referenced_apps"].([]interface{})
+	for _, value := range raw {
+		referenced, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		appID, _ := referenced["app_id"].(float64)
+		data.ReferencedAppIDs.Elems = append(data.ReferencedAppIDs.Elems, types.Int64{Value: int64(appID)})
+
+		if viewID, ok := referenced["view_id"].(float64); ok && viewID != 0 {
+			views.Elems[strconv.FormatInt(int64(appID), 10)] = types.Int64{Value: int64(viewID)}
+		}
+	}
+
+	if data.ReferencedAppViews.Null && len(views.Elems) == 0 {
+		return
+	}
+
+	data.ReferencedAppViews = views
+}
+
+// setCalculation copies the settings of a calculation field into the
+// Terraform data.
+func (data *appFieldData) setCalculation(field *podio.AppField) {
+	script, _ := field.Config.Settings["script"].(string)
+	returnType, _ := field.Config.Settings["return_type"].(string)
+
+	data.ReturnType = types.String{Value: returnType}
+
+	if !data.Script.Null || script != "" {
+		data.Script = types.String{Value: script}
+	}
+
+	if unit, ok := field.Config.Settings["unit"].(string); ok && (!data.Unit.Null || unit != "") {
+		data.Unit = types.String{Value: unit}
+	}
+
+	if decimals, ok := field.Config.Settings["decimals"].(float64); ok && !data.Decimals.Null {
+		data.Decimals = types.Int64{Value: int64(decimals)}
+	}
+}
+
+// addUnmanagedOptions appends the active options that exist in Podio but
+// aren't in the Terraform data, so they are planned for deletion.
+func (data *appFieldData) addUnmanagedOptions(field *podio.AppField) {
+	if field.Type != "category" {
+		return
+	}
+
+	managed := map[int64]bool{}
+	for _, option := range data.Options {
+		managed[option.ID.Value] = true
+	}
+
+	for _, option := range categoryOptions(field.Config.Settings) {
+		if managed[option.ID] || option.Status != "active" {
+			continue
+		}
+
+		data.Options = append(data.Options, option.data())
+	}
 }
 
 type appField struct {
 	provider provider
 }
 
+func (r appField) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var data appFieldData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.Null || data.Type.Unknown {
+		return
+	}
+
+	if data.Type.Value != "category" && (len(data.Options) > 0 || !data.Display.Null) {
+		resp.Diagnostics.AddError(
+			"Invalid category attributes",
+			fmt.Sprintf("`option` blocks and `display` can only be used with `category` fields, not `%s` fields.", data.Type.Value),
+		)
+	}
+
+	if data.Type.Value != "app" && (!data.ReferencedAppIDs.Null || !data.ReferencedAppViews.Null) {
+		resp.Diagnostics.AddError(
+			"Invalid app reference attributes",
+			fmt.Sprintf("`referenced_app_ids` and `referenced_app_views` can only be used with `app` fields, not `%s` fields.", data.Type.Value),
+		)
+	}
+
+	if data.Type.Value != "category" && data.Type.Value != "app" && !data.Multiple.Null {
+		resp.Diagnostics.AddError(
+			"Invalid multiple attribute",
+			fmt.Sprintf("`multiple` can only be used with `category` and `app` fields, not `%s` fields.", data.Type.Value),
+		)
+	}
+
+	if data.Type.Value != "calculation" {
+		if !data.Script.Null || !data.Unit.Null || !data.Decimals.Null || !data.ReturnType.Null {
+			resp.Diagnostics.AddError(
+				"Invalid calculation attributes",
+				fmt.Sprintf("`script`, `unit`, `decimals` and `return_type` can only be used with `calculation` fields, not `%s` fields.", data.Type.Value),
+			)
+		}
+
+		return
+	}
+
+	if data.Script.Null {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("script"),
+			"Missing calculation script",
+			"`script` is required for `calculation` fields.",
+		)
+		return
+	}
+
+	if data.Script.Unknown {
+		return
+	}
+
+	_, err := parseCalculationScript(data.Script.Value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("script"),
+			"Invalid calculation script",
+			fmt.Sprintf("Unable to parse the calculation script: %s", err),
+		)
+	}
+}
+
+func (r appField) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	var state appFieldData
+
+	if !req.State.Raw.IsNull() {
+		diags := req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if req.Plan.Raw.IsNull() {
+		r.warnDataLoss(ctx, state, "Destroying", resp)
+		return
+	}
+
+	var data appFieldData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() && appFieldRequiresReplace(state, data) {
+		r.warnDataLoss(ctx, state, "Replacing", resp)
+	}
+
+	// Keep the IDs of unchanged options in the plan, instead of showing them
+	// as known after apply.
+	if !req.State.Raw.IsNull() && len(data.Options) > 0 {
+		data.Options = planCategoryOptionIDs(data.Options, state.Options)
+
+		diags = resp.Plan.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("option"), data.Options)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The app may not exist yet, in which case the referenced fields can't
+	// exist either and Podio will reject the script on apply.
+	if data.Type.Value != "calculation" || data.Script.Unknown || data.Script.Null || data.AppID.Unknown {
+		return
+	}
+
+	fieldIDs, err := parseCalculationScript(data.Script.Value)
+	if err != nil || len(fieldIDs) == 0 {
+		return
+	}
+
+	app, err := r.provider.client.GetApplication(strconv.Itoa(int(data.AppID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return
+	}
+
+	for _, fieldID := range unknownCalculationFields(fieldIDs, app.Fields) {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("script"),
+			"Unknown field in calculation script",
+			fmt.Sprintf("The calculation script references field %d, which doesn't exist in app %d.", fieldID, data.AppID.Value),
+		)
+	}
+}
+
 func (r appField) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
 	var data appFieldData
 
@@ -50,9 +643,32 @@ func (r appField) Create(ctx context.Context, req tfsdk.CreateResourceRequest, r
 		return
 	}
 
-	// do stuff
+	data.Options = matchCategoryOptions(data.Options, nil)
+
+	config, diags := data.fieldConfig(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	field, err := r.provider.client.CreateAppField(
+		strconv.Itoa(int(data.AppID.Value)),
+		podio.CreateAppFieldParams{
+			Type:       data.Type.Value,
+			ExternalID: data.ExternalID.Value,
+			Config:     config,
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create field: %s", err))
+		return
+	}
+
+	diags = data.setField(field)
+	resp.Diagnostics.Append(diags...)
 
-	tflog.Trace(ctx, "created a space in Podio")
+	tflog.Trace(ctx, "created a field in Podio")
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -68,7 +684,24 @@ func (r appField) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp
 		return
 	}
 
-	// do stuff
+	field, err := r.provider.client.GetAppField(
+		strconv.Itoa(int(data.AppID.Value)),
+		strconv.Itoa(int(data.FieldID.Value)),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get field: %s", err))
+		return
+	}
+
+	if field.Status == "deleted" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = data.setField(field)
+	resp.Diagnostics.Append(diags...)
+
+	data.addUnmanagedOptions(field)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -80,16 +713,95 @@ func (r appField) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, r
 	diags := req.Plan.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
+	var state appFieldData
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := strconv.Itoa(int(data.AppID.Value))
+	fieldID := strconv.Itoa(int(data.FieldID.Value))
+
+	// The current field is needed to keep its position and to match the
+	// category options that exist in Podio.
+	current, err := r.provider.client.GetAppField(appID, fieldID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get field: %s", err))
+		return
+	}
+
+	data.Options = matchCategoryOptions(data.Options, categoryOptions(current.Config.Settings))
+
+	config, diags := data.fieldConfig(ctx)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// do stuff
+	config.Delta = current.Config.Delta
+
+	if data.Type.Value == "category" {
+		config.Settings["options"] = append(
+			config.Settings["options"].([]interface{}),
+			removedCategoryOptions(data.Options, categoryOptions(current.Config.Settings))...,
+		)
+	}
+
+	field, err := r.provider.client.UpdateAppField(appID, fieldID, config)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update field: %s", err))
+		return
+	}
+
+	diags = data.setField(field)
+	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
 
+// warnDataLoss adds a warning to the plan when the field has values on any
+// item, which would be lost by destroying or replacing it.
+func (r appField) warnDataLoss(ctx context.Context, state appFieldData, action string, resp *tfsdk.ModifyResourcePlanResponse) {
+	count, err := r.provider.client.CountItemsWithFieldValues(
+		strconv.Itoa(int(state.AppID.Value)),
+		strconv.Itoa(int(state.FieldID.Value)),
+	)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to check for data loss", fmt.Sprintf("Unable to count the items with values for field %d: %s", state.FieldID.Value, err))
+		return
+	}
+
+	if count == 0 {
+		return
+	}
+
+	detail := fmt.Sprintf("%s field %q (%d) deletes its values from %d items in app %d.", action, state.Label.Value, state.FieldID.Value, count, state.AppID.Value)
+	if !state.AllowDataLoss.Value {
+		detail += " The apply will fail unless `allow_data_loss = true` is applied first."
+	}
+
+	resp.Diagnostics.AddWarning("Field values will be deleted", detail)
+}
+
+// appFieldRequiresReplace reports whether the planned changes to a field
+// require it to be deleted and created again.
+func appFieldRequiresReplace(state, plan appFieldData) bool {
+	if !plan.Type.Unknown && plan.Type.Value != state.Type.Value {
+		return true
+	}
+
+	if !plan.AppID.Unknown && plan.AppID.Value != state.AppID.Value {
+		return true
+	}
+
+	return !plan.ExternalID.Unknown && plan.ExternalID.Value != state.ExternalID.Value
+}
+
 func (r appField) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
 	var data appFieldData
 
@@ -100,11 +812,244 @@ func (r appField) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, r
 		return
 	}
 
-	// do stuff
+	if !data.AllowDataLoss.Value {
+		count, err := r.provider.client.CountItemsWithFieldValues(
+			strconv.Itoa(int(data.AppID.Value)),
+			strconv.Itoa(int(data.FieldID.Value)),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to count the items with values for field: %s", err))
+			return
+		}
+
+		if count > 0 {
+			resp.Diagnostics.AddError(
+				"Field has values",
+				fmt.Sprintf("Field %q (%d) has values on %d items, which would be deleted along with it. Set `allow_data_loss = true` and apply before destroying the field.", data.Label.Value, data.FieldID.Value, count),
+			)
+			return
+		}
+	}
+
+	err := r.provider.client.DeleteAppField(
+		strconv.Itoa(int(data.AppID.Value)),
+		strconv.Itoa(int(data.FieldID.Value)),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete field: %s", err))
+		return
+	}
 
 	resp.State.RemoveResource(ctx)
 }
 
 func (r appField) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
-	tfsdk.ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("app_id"), req, resp)
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected an import ID in the format `app_id/field_id`")
+		return
+	}
+
+	appID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Unable to parse app_id: %s", err))
+		return
+	}
+
+	fieldID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Unable to parse field_id: %s", err))
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("app_id"), appID)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("field_id"), fieldID)
+	resp.Diagnostics.Append(diags...)
+}
+
+// categoryOption is an option of a category field as returned by Podio.
+type categoryOption struct {
+	ID     int64
+	Text   string
+	Color  string
+	Status string
+}
+
+func (o categoryOption) data() appFieldOptionData {
+	return appFieldOptionData{
+		ID:     types.Int64{Value: o.ID},
+		Text:   types.String{Value: o.Text},
+		Color:  types.String{Value: o.Color},
+		Status: types.String{Value: o.Status},
+	}
+}
+
+// categoryOptions reads the options out of the settings of a category field.
+func categoryOptions(settings map[string]interface{}) []categoryOption {
+	options := []categoryOption{}
+
+	raw, _ := settings["options"].([]interface{})
+	for _, value := range raw {
+		option, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id, _ := option["id"].(float64)
+		text, _ := option["text"].(string)
+		color, _ := option["color"].(string)
+		status, _ := option["status"].(string)
+
+		options = append(options, categoryOption{
+			ID:     int64(id),
+			Text:   text,
+			Color:  color,
+			Status: status,
+		})
+	}
+
+	return options
+}
+
+// planCategoryOptionIDs copies the IDs of the prior options to the planned
+// options with the same text that don't have an ID yet. Other options are
+// left unknown.
+func planCategoryOptionIDs(planned, prior []appFieldOptionData) []appFieldOptionData {
+	used := map[int64]bool{}
+	for _, option := range planned {
+		if !option.ID.Null && !option.ID.Unknown {
+			used[option.ID.Value] = true
+		}
+	}
+
+	matched := make([]appFieldOptionData, len(planned))
+	copy(matched, planned)
+
+	for i, option := range matched {
+		if !option.ID.Unknown || option.Text.Unknown {
+			continue
+		}
+
+		for _, p := range prior {
+			if p.ID.Null || p.ID.Unknown || used[p.ID.Value] || p.Text.Value != option.Text.Value {
+				continue
+			}
+
+			matched[i].ID = p.ID
+			used[p.ID.Value] = true
+			break
+		}
+	}
+
+	return matched
+}
+
+// matchCategoryOptions fills in the IDs of planned options that don't have
+// one by matching them with the remote options by exact text. Options that
+// don't match are left without an ID, so they're created as new options.
+// Matching by anything looser would hand the ID of a removed option, and with
+// it the values of existing items, to an unrelated option.
+func matchCategoryOptions(planned []appFieldOptionData, remote []categoryOption) []appFieldOptionData {
+	used := map[int64]bool{}
+	for _, option := range planned {
+		if !option.ID.Null && !option.ID.Unknown {
+			used[option.ID.Value] = true
+		}
+	}
+
+	matched := make([]appFieldOptionData, len(planned))
+	copy(matched, planned)
+
+	for i, option := range matched {
+		if !option.ID.Null && !option.ID.Unknown {
+			continue
+		}
+
+		matched[i].ID = types.Int64{Null: true}
+
+		for _, r := range remote {
+			if r.Text == option.Text.Value && !used[r.ID] {
+				matched[i].ID = types.Int64{Value: r.ID}
+				used[r.ID] = true
+				break
+			}
+		}
+	}
+
+	return matched
+}
+
+// removedCategoryOptions returns the active remote options that aren't
+// planned anymore, marked as deleted so item values are kept.
+func removedCategoryOptions(planned []appFieldOptionData, remote []categoryOption) []interface{} {
+	kept := map[int64]bool{}
+	for _, option := range planned {
+		if !option.ID.Null && !option.ID.Unknown {
+			kept[option.ID.Value] = true
+		}
+	}
+
+	removed := []interface{}{}
+	for _, option := range remote {
+		if kept[option.ID] || option.Status != "active" {
+			continue
+		}
+
+		removed = append(removed, map[string]interface{}{
+			"id":     option.ID,
+			"text":   option.Text,
+			"color":  option.Color,
+			"status": "deleted",
+		})
+	}
+
+	return removed
+}
+
+// appFieldSettings decodes the JSON encoded settings of a field.
+func appFieldSettings(config types.String) (map[string]interface{}, error) {
+	settings := map[string]interface{}{}
+
+	if config.Null || config.Unknown || config.Value == "" {
+		return settings, nil
+	}
+
+	err := json.Unmarshal([]byte(config.Value), &settings)
+	return settings, err
+}
+
+// appFieldSettingsValue encodes the settings Podio returned for a field, only
+// keeping the keys that were previously configured. Podio fills in defaults
+// for every setting, so comparing the full document would always show a diff.
+func appFieldSettingsValue(prior types.String, remote map[string]interface{}) (types.String, error) {
+	if prior.Null || prior.Unknown {
+		return types.String{Null: true}, nil
+	}
+
+	configured, err := appFieldSettings(prior)
+	if err != nil {
+		return prior, err
+	}
+
+	settings := map[string]interface{}{}
+	for key := range configured {
+		if value, ok := remote[key]; ok {
+			settings[key] = value
+		}
+	}
+
+	// Keep the configured formatting when nothing changed on the Podio side.
+	if reflect.DeepEqual(configured, settings) {
+		return prior, nil
+	}
+
+	raw, err := json.Marshal(settings)
+	if err != nil {
+		return prior, err
+	}
+
+	return types.String{Value: string(raw)}, nil
 }
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testOption(id types.Int64, text string) appFieldOptionData {
+	return appFieldOptionData{
+		ID:   id,
+		Text: types.String{Value: text},
+	}
+}
+
+func optionIDs(options []appFieldOptionData) []types.Int64 {
+	ids := []types.Int64{}
+	for _, option := range options {
+		ids = append(ids, option.ID)
+	}
+
+	return ids
+}
+
+func TestMatchCategoryOptions(t *testing.T) {
+	unknown := types.Int64{Unknown: true}
+	null := types.Int64{Null: true}
+
+	remote := []categoryOption{
+		{ID: 1, Text: "Backlog", Status: "active"},
+		{ID: 2, Text: "In Progress", Status: "active"},
+		{ID: 3, Text: "Done", Status: "active"},
+	}
+
+	cases := []struct {
+		name    string
+		planned []appFieldOptionData
+		remote  []categoryOption
+		ids     []types.Int64
+	}{
+		{
+			name: "new field",
+			planned: []appFieldOptionData{
+				testOption(unknown, "Backlog"),
+				testOption(unknown, "Done"),
+			},
+			ids: []types.Int64{null, null},
+		},
+		{
+			name: "matched by text regardless of position",
+			planned: []appFieldOptionData{
+				testOption(unknown, "Done"),
+				testOption(unknown, "Backlog"),
+			},
+			remote: remote,
+			ids:    []types.Int64{{Value: 3}, {Value: 1}},
+		},
+		{
+			name: "explicit id keeps a renamed option",
+			planned: []appFieldOptionData{
+				testOption(types.Int64{Value: 1}, "To do"),
+				testOption(unknown, "In Progress"),
+			},
+			remote: remote,
+			ids:    []types.Int64{{Value: 1}, {Value: 2}},
+		},
+		{
+			name: "renamed option without id is new",
+			planned: []appFieldOptionData{
+				testOption(unknown, "To do"),
+				testOption(unknown, "In Progress"),
+				testOption(unknown, "Done"),
+			},
+			remote: remote,
+			ids:    []types.Int64{null, {Value: 2}, {Value: 3}},
+		},
+		{
+			name: "removed option's id is not reused at its position",
+			planned: []appFieldOptionData{
+				testOption(unknown, "Blocked"),
+				testOption(unknown, "Done"),
+			},
+			remote: remote,
+			ids:    []types.Int64{null, {Value: 3}},
+		},
+		{
+			name: "explicit id is not matched twice",
+			planned: []appFieldOptionData{
+				testOption(unknown, "Backlog"),
+				testOption(types.Int64{Value: 1}, "Icebox"),
+			},
+			remote: remote,
+			ids:    []types.Int64{null, {Value: 1}},
+		},
+		{
+			name: "duplicate texts get one id each",
+			planned: []appFieldOptionData{
+				testOption(unknown, "Done"),
+				testOption(unknown, "Done"),
+			},
+			remote: remote,
+			ids:    []types.Int64{{Value: 3}, null},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ids := optionIDs(matchCategoryOptions(c.planned, c.remote))
+
+			if !reflect.DeepEqual(ids, c.ids) {
+				t.Errorf("expected IDs %v, got %v", c.ids, ids)
+			}
+		})
+	}
+}
+
+func TestPlanCategoryOptionIDs(t *testing.T) {
+	unknown := types.Int64{Unknown: true}
+
+	prior := []appFieldOptionData{
+		testOption(types.Int64{Value: 1}, "Backlog"),
+		testOption(types.Int64{Value: 2}, "In Progress"),
+	}
+
+	planned := []appFieldOptionData{
+		testOption(unknown, "In Progress"),
+		testOption(unknown, "Review"),
+		testOption(unknown, "Backlog"),
+	}
+
+	ids := optionIDs(planCategoryOptionIDs(planned, prior))
+	expected := []types.Int64{{Value: 2}, unknown, {Value: 1}}
+
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected IDs %v, got %v", expected, ids)
+	}
+}
+
+func TestAppFieldSettingsValue(t *testing.T) {
+	remote := map[string]interface{}{
+		"size":              "large",
+		"format":            "html",
+		"referenced_apps":   []interface{}{map[string]interface{}{"app_id": float64(12)}},
+		"default_view_mode": "table",
+	}
+
+	cases := []struct {
+		name     string
+		prior    types.String
+		expected types.String
+		err      bool
+	}{
+		{
+			name:     "not configured",
+			prior:    types.String{Null: true},
+			expected: types.String{Null: true},
+		},
+		{
+			name:     "unknown",
+			prior:    types.String{Unknown: true},
+			expected: types.String{Null: true},
+		},
+		{
+			name:     "unchanged keeps formatting",
+			prior:    types.String{Value: "{ \"size\": \"large\" }"},
+			expected: types.String{Value: "{ \"size\": \"large\" }"},
+		},
+		{
+			name:     "only configured keys",
+			prior:    types.String{Value: `{"size":"small","format":"html"}`},
+			expected: types.String{Value: `{"format":"html","size":"large"}`},
+		},
+		{
+			name:     "nested values",
+			prior:    types.String{Value: `{"referenced_apps":[]}`},
+			expected: types.String{Value: `{"referenced_apps":[{"app_id":12}]}`},
+		},
+		{
+			name:     "key missing in Podio is dropped",
+			prior:    types.String{Value: `{"size":"large","unknown":true}`},
+			expected: types.String{Value: `{"size":"large"}`},
+		},
+		{
+			name:     "empty object",
+			prior:    types.String{Value: "{}"},
+			expected: types.String{Value: "{}"},
+		},
+		{
+			name:     "invalid JSON",
+			prior:    types.String{Value: "{"},
+			expected: types.String{Value: "{"},
+			err:      true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			value, err := appFieldSettingsValue(c.prior, remote)
+
+			if c.err != (err != nil) {
+				t.Fatalf("expected error %t, got %v", c.err, err)
+			}
+
+			if !value.Equal(c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, value)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = appFieldsDataSourceType{}
+var _ tfsdk.DataSource = appFieldsDataSource{}
+
+type appFieldsDataSourceType struct{}
+
+func (t appFieldsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "The fields of an app in Podio",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"app_id": {
+				Type:        types.Int64Type,
+				Description: "The numeric ID of the app.",
+				Required:    true,
+			},
+			"include_deleted": {
+				Type:        types.BoolType,
+				Description: "Also list deleted fields, after the active ones. Defaults to `false`.",
+				Optional:    true,
+			},
+			"fields": {
+				Description: "Fields of the app, in the order they appear",
+				Computed:    true,
+				Attributes:  tfsdk.ListNestedAttributes(appFieldListAttributes(), tfsdk.ListNestedAttributesOptions{}),
+			},
+			"by_external_id": {
+				Description: "Active fields of the app keyed by their external ID",
+				Computed:    true,
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"field_id": {
+						Description: "ID of the field",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"type": {
+						Description: "Type of the field",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"label": {
+						Description: "Label of the field",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t appFieldsDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return appFieldsDataSource{
+		provider: provider,
+	}, diags
+}
+
+type appFieldsDataSourceData struct {
+	AppID          types.Int64                            `tfsdk:"app_id"`
+	IncludeDeleted types.Bool                             `tfsdk:"include_deleted"`
+	Fields         []appFieldListData                     `tfsdk:"fields"`
+	ByExternalID   map[string]appFieldsDataSourceFieldRef `tfsdk:"by_external_id"`
+}
+
+type appFieldsDataSourceFieldRef struct {
+	FieldID types.Int64  `tfsdk:"field_id"`
+	Type    types.String `tfsdk:"type"`
+	Label   types.String `tfsdk:"label"`
+}
+
+type appFieldsDataSource struct {
+	provider provider
+}
+
+func (d appFieldsDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data appFieldsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := d.provider.client.GetApplication(fmt.Sprintf("%d", data.AppID.Value))
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching app", fmt.Sprintf("Unable to fetch app, got error: %s", err))
+		return
+	}
+
+	fields := sortedAppFields(app.Fields)
+
+	data.ByExternalID = map[string]appFieldsDataSourceFieldRef{}
+	for _, field := range fields {
+		data.ByExternalID[field.ExternalID] = appFieldsDataSourceFieldRef{
+			FieldID: types.Int64{Value: int64(field.FieldID)},
+			Type:    types.String{Value: field.Type},
+			Label:   types.String{Value: field.Config.Label},
+		}
+	}
+
+	if data.IncludeDeleted.Value {
+		for _, field := range app.Fields {
+			if field.Status == "deleted" {
+				fields = append(fields, field)
+			}
+		}
+	}
+
+	data.Fields, diags = newAppFieldListData(fields)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,376 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kayteh/podio-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = appFormResourceType{}
+var _ tfsdk.Resource = appFormResource{}
+var _ tfsdk.ResourceWithValidateConfig = appFormResource{}
+
+type appFormResourceType struct{}
+
+func (t appFormResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "A public webform that lets anyone create items in an app",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"app_id": {
+				MarkdownDescription: "ID of the app",
+				Type:                types.Int64Type,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"form_id": {
+				MarkdownDescription: "ID of the form",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"field_ids": {
+				MarkdownDescription: "IDs of the fields included in the form. Mutually exclusive with `external_ids`.",
+				Type:                types.ListType{ElemType: types.Int64Type},
+				Optional:            true,
+			},
+			"external_ids": {
+				MarkdownDescription: "External IDs of the fields included in the form. Mutually exclusive with `field_ids`.",
+				Type:                types.ListType{ElemType: types.StringType},
+				Optional:            true,
+			},
+			"domains": {
+				MarkdownDescription: "Domains the form can be embedded on",
+				Type:                types.SetType{ElemType: types.StringType},
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"css": {
+				MarkdownDescription: "Custom CSS applied to the form",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"success_message": {
+				MarkdownDescription: "Message shown after the form has been submitted",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"allow_attachments": {
+				MarkdownDescription: "True if files can be attached to the created items",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"captcha": {
+				MarkdownDescription: "True if a captcha must be solved to submit the form",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"embed_code": {
+				MarkdownDescription: "HTML snippet that embeds the form on a web page",
+				Type:                types.StringType,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t appFormResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return appFormResource{
+		provider: provider,
+	}, diags
+}
+
+type appFormResourceData struct {
+	AppID            types.Int64  `tfsdk:"app_id"`
+	FormID           types.Int64  `tfsdk:"form_id"`
+	FieldIDs         types.List   `tfsdk:"field_ids"`
+	ExternalIDs      types.List   `tfsdk:"external_ids"`
+	Domains          types.Set    `tfsdk:"domains"`
+	CSS              types.String `tfsdk:"css"`
+	SuccessMessage   types.String `tfsdk:"success_message"`
+	AllowAttachments types.Bool   `tfsdk:"allow_attachments"`
+	Captcha          types.Bool   `tfsdk:"captcha"`
+	EmbedCode        types.String `tfsdk:"embed_code"`
+}
+
+// setForm copies the form returned by Podio into the Terraform data. The
+// fields of the app are used to report the included fields by external ID.
+func (data *appFormResourceData) setForm(form *podio.Form, fields []podio.AppField) {
+	data.FormID = types.Int64{Value: int64(form.FormID)}
+	data.AppID = types.Int64{Value: int64(form.AppID)}
+	data.CSS = types.String{Value: form.CSS}
+	data.SuccessMessage = types.String{Value: form.SuccessMessage}
+	data.AllowAttachments = types.Bool{Value: form.AllowAttachments}
+	data.Captcha = types.Bool{Value: form.Captcha}
+	data.EmbedCode = types.String{Value: fmt.Sprintf(
+		`<script src="https://podio.com/webforms/%d/%d.js"></script><script type="text/javascript">_podioWebForm.render("%d")</script>`,
+		form.AppID, form.FormID, form.FormID,
+	)}
+
+	data.Domains = types.Set{ElemType: types.StringType, Elems: []attr.Value{}}
+	for _, domain := range form.Domains {
+		data.Domains.Elems = append(data.Domains.Elems, types.String{Value: domain})
+	}
+
+	if !data.ExternalIDs.Null {
+		externalIDs := map[int]string{}
+		for _, field := range fields {
+			externalIDs[field.FieldID] = field.ExternalID
+		}
+
+		data.ExternalIDs = types.List{ElemType: types.StringType, Elems: []attr.Value{}}
+		for _, fieldID := range form.FieldIDs {
+			data.ExternalIDs.Elems = append(data.ExternalIDs.Elems, types.String{Value: externalIDs[fieldID]})
+		}
+
+		return
+	}
+
+	data.FieldIDs = types.List{ElemType: types.Int64Type, Elems: []attr.Value{}}
+	for _, fieldID := range form.FieldIDs {
+		data.FieldIDs.Elems = append(data.FieldIDs.Elems, types.Int64{Value: int64(fieldID)})
+	}
+}
+
+type appFormResource struct {
+	provider provider
+}
+
+func (r appFormResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var data appFormResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.FieldIDs.Null && !data.ExternalIDs.Null {
+		resp.Diagnostics.AddError("Ambiguous form fields", "Only set one of `field_ids` or `external_ids`, not both.")
+		return
+	}
+
+	if data.FieldIDs.Null && data.ExternalIDs.Null {
+		resp.Diagnostics.AddError("No form fields specified", "Either `field_ids` or `external_ids` must be specified")
+	}
+}
+
+// formParams builds the Podio form from the Terraform data, resolving the
+// external IDs of the included fields against the fields of the app.
+func (data appFormResourceData) formParams(ctx context.Context, fields []podio.AppField) (podio.CreateFormParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := podio.CreateFormParams{
+		FieldIDs:         []int{},
+		Domains:          []string{},
+		CSS:              data.CSS.Value,
+		SuccessMessage:   data.SuccessMessage.Value,
+		AllowAttachments: data.AllowAttachments.Value,
+		Captcha:          data.Captcha.Value,
+	}
+
+	diags.Append(data.Domains.ElementsAs(ctx, &params.Domains, true)...)
+
+	if !data.FieldIDs.Null {
+		var fieldIDs []int64
+		diags.Append(data.FieldIDs.ElementsAs(ctx, &fieldIDs, false)...)
+
+		for _, fieldID := range fieldIDs {
+			params.FieldIDs = append(params.FieldIDs, int(fieldID))
+		}
+
+		return params, diags
+	}
+
+	var externalIDs []string
+	diags.Append(data.ExternalIDs.ElementsAs(ctx, &externalIDs, false)...)
+
+	fieldIDs := map[string]int{}
+	for _, field := range fields {
+		if field.Status != "deleted" {
+			fieldIDs[field.ExternalID] = field.FieldID
+		}
+	}
+
+	for _, externalID := range externalIDs {
+		fieldID, ok := fieldIDs[externalID]
+		if !ok {
+			diags.AddError("Unknown field", fmt.Sprintf("Field %q doesn't exist in app %d", externalID, data.AppID.Value))
+			continue
+		}
+
+		params.FieldIDs = append(params.FieldIDs, fieldID)
+	}
+
+	return params, diags
+}
+
+func (r appFormResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data appFormResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := strconv.Itoa(int(data.AppID.Value))
+
+	app, err := r.provider.client.GetApplication(appID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return
+	}
+
+	params, diags := data.formParams(ctx, app.Fields)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	form, err := r.provider.client.CreateForm(appID, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create form: %s", err))
+		return
+	}
+
+	data.setForm(form, app.Fields)
+
+	tflog.Trace(ctx, "created a form in Podio")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r appFormResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data appFormResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	form, err := r.provider.client.GetForm(strconv.Itoa(int(data.FormID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get form: %s", err))
+		return
+	}
+
+	app, err := r.provider.client.GetApplication(strconv.Itoa(form.AppID))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return
+	}
+
+	data.setForm(form, app.Fields)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r appFormResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data appFormResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := r.provider.client.GetApplication(strconv.Itoa(int(data.AppID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return
+	}
+
+	params, diags := data.formParams(ctx, app.Fields)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	form, err := r.provider.client.UpdateForm(strconv.Itoa(int(data.FormID.Value)), params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update form: %s", err))
+		return
+	}
+
+	data.setForm(form, app.Fields)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r appFormResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data appFormResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.provider.client.DeleteForm(strconv.Itoa(int(data.FormID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete form: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r appFormResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	formID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Unable to parse form_id: %s", err))
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("form_id"), formID)
+	resp.Diagnostics.Append(diags...)
+}
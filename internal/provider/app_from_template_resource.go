@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kayteh/podio-go"
+	"github.com/kayteh/terraform-provider-podio/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = appFromTemplateResourceType{}
+var _ tfsdk.Resource = appFromTemplateResource{}
+var _ tfsdk.ResourceWithValidateConfig = appFromTemplateResource{}
+
+type appFromTemplateResourceType struct{}
+
+func (t appFromTemplateResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "An app installed into a space by copying an existing app or installing an App Market share. " +
+			"Only the installation is managed here. To manage the settings of the app, import `app_id` into a `podio_app` resource and set `on_destroy = \"keep\"` here.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"space_id": {
+				MarkdownDescription: "ID of the space to install the app into",
+				Type:                types.Int64Type,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"source_app_id": {
+				MarkdownDescription: "ID of the app to copy. Mutually exclusive with `share_id`.",
+				Type:                types.Int64Type,
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"share_id": {
+				MarkdownDescription: "ID of the App Market share to install. Mutually exclusive with `source_app_id`.",
+				Type:                types.Int64Type,
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"on_destroy": {
+				MarkdownDescription: "What to do with the installed apps when this resource is destroyed. One of: `delete` (default), `deactivate`, `keep`.",
+				Type:                types.StringType,
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSliceValidator{"delete", "deactivate", "keep"},
+				},
+			},
+			"app_id": {
+				MarkdownDescription: "ID of the installed app. For App Market shares with several apps, this is the first one.",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"app_ids": {
+				MarkdownDescription: "IDs of all apps installed",
+				Type:                types.ListType{ElemType: types.Int64Type},
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				MarkdownDescription: "Name of the installed app",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"field_ids": {
+				MarkdownDescription: "IDs of the fields of the installed app, keyed by their external ID",
+				Type:                types.MapType{ElemType: types.Int64Type},
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (t appFromTemplateResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return appFromTemplateResource{
+		provider: provider,
+	}, diags
+}
+
+type appFromTemplateResourceData struct {
+	SpaceID     types.Int64  `tfsdk:"space_id"`
+	SourceAppID types.Int64  `tfsdk:"source_app_id"`
+	ShareID     types.Int64  `tfsdk:"share_id"`
+	OnDestroy   types.String `tfsdk:"on_destroy"`
+	AppID       types.Int64  `tfsdk:"app_id"`
+	AppIDs      types.List   `tfsdk:"app_ids"`
+	Name        types.String `tfsdk:"name"`
+	FieldIDs    types.Map    `tfsdk:"field_ids"`
+}
+
+// setApp copies the installed app returned by Podio into the Terraform data.
+func (data *appFromTemplateResourceData) setApp(app *podio.App) {
+	data.AppID = types.Int64{Value: int64(app.AppID)}
+	data.Name = types.String{Value: app.Config.Name}
+
+	data.FieldIDs = types.Map{ElemType: types.Int64Type, Elems: map[string]attr.Value{}}
+	for _, field := range app.Fields {
+		if field.Status != "deleted" {
+			data.FieldIDs.Elems[field.ExternalID] = types.Int64{Value: int64(field.FieldID)}
+		}
+	}
+}
+
+type appFromTemplateResource struct {
+	provider provider
+}
+
+func (r appFromTemplateResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var data appFromTemplateResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SourceAppID.Null && !data.ShareID.Null {
+		resp.Diagnostics.AddError("Ambiguous template", "Only set one of `source_app_id` or `share_id`, not both.")
+		return
+	}
+
+	if data.SourceAppID.Null && data.ShareID.Null {
+		resp.Diagnostics.AddError("No template specified", "Either `source_app_id` or `share_id` must be specified")
+	}
+}
+
+func (r appFromTemplateResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data appFromTemplateResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := strconv.Itoa(int(data.SpaceID.Value))
+
+	var appIDs []int
+
+	if !data.SourceAppID.Null {
+		appID, err := r.provider.client.InstallApplication(strconv.Itoa(int(data.SourceAppID.Value)), spaceID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to copy app: %s", err))
+			return
+		}
+
+		appIDs = []int{appID}
+	} else {
+		installed, err := r.provider.client.InstallAppMarketShare(strconv.Itoa(int(data.ShareID.Value)), spaceID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to install App Market share: %s", err))
+			return
+		}
+
+		appIDs = installed
+	}
+
+	if len(appIDs) == 0 {
+		resp.Diagnostics.AddError("Client Error", "Podio didn't install any apps")
+		return
+	}
+
+	tflog.Trace(ctx, "installed an app in Podio")
+
+	data.AppIDs = types.List{ElemType: types.Int64Type, Elems: []attr.Value{}}
+	for _, appID := range appIDs {
+		data.AppIDs.Elems = append(data.AppIDs.Elems, types.Int64{Value: int64(appID)})
+	}
+
+	app, err := r.provider.client.GetApplication(strconv.Itoa(appIDs[0]))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return
+	}
+
+	data.setApp(app)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r appFromTemplateResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data appFromTemplateResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := r.provider.client.GetApplication(strconv.Itoa(int(data.AppID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return
+	}
+
+	data.setApp(app)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update only changes on_destroy, as everything else requires replacement.
+func (r appFromTemplateResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data appFromTemplateResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := r.provider.client.GetApplication(strconv.Itoa(int(data.AppID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return
+	}
+
+	data.setApp(app)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r appFromTemplateResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data appFromTemplateResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.OnDestroy.Value == "keep" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var appIDs []int64
+	diags = data.AppIDs.ElementsAs(ctx, &appIDs, true)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, appID := range appIDs {
+		var err error
+
+		if data.OnDestroy.Value == "deactivate" {
+			err = r.provider.client.DeactivateApplication(strconv.Itoa(int(appID)))
+		} else {
+			err = r.provider.client.DeleteApplication(strconv.Itoa(int(appID)))
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove app %d: %s", appID, err))
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r appFromTemplateResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	resp.Diagnostics.AddError("Import not supported", "podio_app_from_template can't be imported, as the template an app was installed from isn't known. Import the app as a podio_app instead.")
+}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +19,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ tfsdk.ResourceType = appResourceType{}
 var _ tfsdk.Resource = appResource{}
+var _ tfsdk.ResourceWithValidateConfig = appResource{}
+var _ tfsdk.ResourceWithModifyPlan = appResource{}
 
 type appResourceType struct{}
 
@@ -35,6 +38,26 @@ func (t appResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diag
 				MarkdownDescription: "ID of the app",
 				Type:                types.Int64Type,
 				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"active": {
+				MarkdownDescription: "Whether the app is active. Inactive apps are hidden from the space but keep their items.",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"on_destroy": {
+				MarkdownDescription: "What to do with the app when it is destroyed. One of: `delete` (default), `deactivate`. With `deactivate`, creating the app again reactivates the deactivated app with the same `external_id` in the space.",
+				Type:                types.StringType,
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSliceValidator{"delete", "deactivate"},
+				},
 			},
 			"name": {
 				MarkdownDescription: "Name of the app",
@@ -46,6 +69,9 @@ func (t appResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diag
 				Type:                types.StringType,
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 				Validators: []tfsdk.AttributeValidator{
 					validators.StringInSliceValidator{"standard", "meeting", "contact"},
 				},
@@ -60,17 +86,27 @@ func (t appResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diag
 				Type:                types.StringType,
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"usage": {
 				MarkdownDescription: "How the app should be used.",
 				Type:                types.StringType,
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"icon": {
 				MarkdownDescription: "Icon of the app. Must be in the format `12.png`. You might want to use `podio_icon_search` data source to pick one as the numbers are essentially useless.",
 				Type:                types.StringType,
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 				Validators: []tfsdk.AttributeValidator{
 					validators.StringMatchesRegexpValidator{
 						Regexp: regexp.MustCompile(`^\d+\.png$`),
@@ -82,30 +118,276 @@ func (t appResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diag
 				Type:                types.BoolType,
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"allow_attachments": {
 				MarkdownDescription: "True if attachment of files to an item is allowed",
 				Type:                types.BoolType,
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"allow_comments": {
 				MarkdownDescription: "True if comments are allowed",
 				Type:                types.BoolType,
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"silent_creates": {
 				MarkdownDescription: "True if item creates should not be posted to the stream",
 				Type:                types.BoolType,
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
 			},
 			"silent_edits": {
 				MarkdownDescription: "True if item edits should not be posted to the stream",
 				Type:                types.BoolType,
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"external_id": {
+				MarkdownDescription: "External ID of the app, useful for referencing the app from other systems",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"allow_create": {
+				MarkdownDescription: "True if new items can be created",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"allow_tags": {
+				MarkdownDescription: "True if tagging of items is allowed",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"default_view": {
+				MarkdownDescription: "The default view of the app items on the app main page. One of: `badge`, `table`, `calendar`, `card`, `stream`",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSliceValidator{"badge", "table", "calendar", "card", "stream"},
+				},
+			},
+			"disable_notifications": {
+				MarkdownDescription: "True if notifications should not be sent for changes to items in this app",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"fivestar": {
+				MarkdownDescription: "True if fivestar rating is enabled on an item",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"fivestar_label": {
+				MarkdownDescription: "If fivestar rating is enabled, this is the label that will be presented to the users",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"thumbs": {
+				MarkdownDescription: "True if thumbs ratings are enabled on an item",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"thumbs_label": {
+				MarkdownDescription: "If thumbs ratings are enabled, this is the label that will be presented to the users",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"approved": {
+				MarkdownDescription: "True if an item can be approved",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"rsvp": {
+				MarkdownDescription: "True if RSVP is enabled on an item",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"rsvp_label": {
+				MarkdownDescription: "If RSVP is enabled, this is the label that will be presented to the users",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"yesno": {
+				MarkdownDescription: "True if yes/no rating is enabled on an item",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"yesno_label": {
+				MarkdownDescription: "If yes/no rating is enabled, this is the label that will be presented to the users",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"tasks": {
+				MarkdownDescription: "List of tasks that will be created for every new item in the app",
+				Type:                types.ListType{ElemType: types.StringType},
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"show_app_item_id": {
+				MarkdownDescription: "True if the app item ID should be shown on items",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"app_item_id_prefix": {
+				MarkdownDescription: "Prefix to show in front of the app item ID when `show_app_item_id` is enabled",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"calendar_color_category_field": {
+				MarkdownDescription: "ID of the category field used to color items in the calendar view",
+				Type:                types.Int64Type,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"template_json": {
+				MarkdownDescription: "JSON encoded app template, e.g. from the `podio_app_template` data source. Config attributes that aren't set take their value from the template, and the fields, views and hooks of the template are created or updated whenever it changes. Fields defined in `field` blocks take precedence over the template, and nothing is removed when it's taken out of the template.",
+				Type:                types.StringType,
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringIsJSONValidator{},
+				},
+			},
+			"field_ids": {
+				MarkdownDescription: "IDs of the fields defined in `field` blocks, keyed by their `external_id`",
+				Type:                types.MapType{ElemType: types.Int64Type},
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]tfsdk.Block{
+			"field": {
+				MarkdownDescription: "A field within the app. Fields are matched by `external_id` across updates, so reordering them does not delete any data. Fields created outside of these blocks, e.g. with `podio_app_field`, are left alone.",
+				NestingMode:         tfsdk.BlockNestingModeList,
+				Attributes: map[string]tfsdk.Attribute{
+					"type": {
+						MarkdownDescription: "Type of the field. Changing this deletes the field and all of its values before creating it again, see `allow_data_loss`.",
+						Type:                types.StringType,
+						Required:            true,
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSliceValidator(appFieldTypes),
+						},
+					},
+					"label": {
+						MarkdownDescription: "Label of the field",
+						Type:                types.StringType,
+						Required:            true,
+					},
+					"external_id": {
+						MarkdownDescription: "External ID of the field. Must be unique within the app.",
+						Type:                types.StringType,
+						Required:            true,
+					},
+					"description": {
+						MarkdownDescription: "Description of the field",
+						Type:                types.StringType,
+						Optional:            true,
+					},
+					"required": {
+						MarkdownDescription: "True if the field is required when creating and editing items",
+						Type:                types.BoolType,
+						Optional:            true,
+					},
+					"config": {
+						MarkdownDescription: "JSON encoded settings of the field, which depend on its type. Only the settings given here are compared against Podio.",
+						Type:                types.StringType,
+						Optional:            true,
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringIsJSONValidator{},
+						},
+					},
+					"allow_data_loss": {
+						MarkdownDescription: "Deleting a field deletes its values from every item. Unless this is `true`, removing the block or changing the `type` of a field that has values on any item fails. Must be applied before the field is removed to take effect.",
+						Type:                types.BoolType,
+						Optional:            true,
+					},
+				},
 			},
 		},
 	}, nil
@@ -122,6 +404,8 @@ func (t appResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tf
 type appResourceData struct {
 	SpaceID          types.Int64  `tfsdk:"space_id"`
 	AppID            types.Int64  `tfsdk:"app_id"`
+	Active           types.Bool   `tfsdk:"active"`
+	OnDestroy        types.String `tfsdk:"on_destroy"`
 	Name             types.String `tfsdk:"name"`
 	Type             types.String `tfsdk:"type"`
 	ItemName         types.String `tfsdk:"item_name"`
@@ -133,13 +417,282 @@ type appResourceData struct {
 	AllowComments    types.Bool   `tfsdk:"allow_comments"`
 	SilentCreates    types.Bool   `tfsdk:"silent_creates"`
 	SilentEdits      types.Bool   `tfsdk:"silent_edits"`
+
+	ExternalID                 types.String `tfsdk:"external_id"`
+	AllowCreate                types.Bool   `tfsdk:"allow_create"`
+	AllowTags                  types.Bool   `tfsdk:"allow_tags"`
+	DefaultView                types.String `tfsdk:"default_view"`
+	DisableNotifications       types.Bool   `tfsdk:"disable_notifications"`
+	Fivestar                   types.Bool   `tfsdk:"fivestar"`
+	FivestarLabel              types.String `tfsdk:"fivestar_label"`
+	Thumbs                     types.Bool   `tfsdk:"thumbs"`
+	ThumbsLabel                types.String `tfsdk:"thumbs_label"`
+	Approved                   types.Bool   `tfsdk:"approved"`
+	RSVP                       types.Bool   `tfsdk:"rsvp"`
+	RSVPLabel                  types.String `tfsdk:"rsvp_label"`
+	YesNo                      types.Bool   `tfsdk:"yesno"`
+	YesNoLabel                 types.String `tfsdk:"yesno_label"`
+	Tasks                      types.List   `tfsdk:"tasks"`
+	ShowAppItemID              types.Bool   `tfsdk:"show_app_item_id"`
+	AppItemIDPrefix            types.String `tfsdk:"app_item_id_prefix"`
+	CalendarColorCategoryField types.Int64  `tfsdk:"calendar_color_category_field"`
+
+	TemplateJSON types.String        `tfsdk:"template_json"`
+	FieldIDs     types.Map           `tfsdk:"field_ids"`
+	Fields       []appFieldBlockData `tfsdk:"field"`
+}
+
+type appFieldBlockData struct {
+	Type          types.String `tfsdk:"type"`
+	Label         types.String `tfsdk:"label"`
+	ExternalID    types.String `tfsdk:"external_id"`
+	Description   types.String `tfsdk:"description"`
+	Required      types.Bool   `tfsdk:"required"`
+	Config        types.String `tfsdk:"config"`
+	AllowDataLoss types.Bool   `tfsdk:"allow_data_loss"`
+}
+
+// appConfigDefaults is the config Podio gives a new app for the attributes
+// that aren't sent.
+var appConfigDefaults = podio.AppConfig{
+	Type:             "standard",
+	DefaultView:      "badge",
+	AllowEdit:        true,
+	AllowAttachments: true,
+	AllowComments:    true,
+	AllowCreate:      true,
+	AllowTags:        true,
+}
+
+// appConfig builds the config sent to Podio. Attributes that are unknown,
+// i.e. not configured on create, keep the Podio defaults instead of being
+// sent as zero values.
+func (data appResourceData) appConfig(ctx context.Context) (podio.AppConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := appConfigDefaults
+
+	setKnownString(&config.Name, data.Name)
+	setKnownString(&config.Type, data.Type)
+	setKnownString(&config.ItemName, data.ItemName)
+	setKnownString(&config.Description, data.Description)
+	setKnownString(&config.Usage, data.Usage)
+	setKnownString(&config.Icon, data.Icon)
+	setKnownBool(&config.AllowEdit, data.AllowEdit)
+	setKnownBool(&config.AllowAttachments, data.AllowAttachments)
+	setKnownBool(&config.AllowComments, data.AllowComments)
+	setKnownBool(&config.SilentCreates, data.SilentCreates)
+	setKnownBool(&config.SilentEdits, data.SilentEdits)
+	setKnownString(&config.ExternalID, data.ExternalID)
+	setKnownBool(&config.AllowCreate, data.AllowCreate)
+	setKnownBool(&config.AllowTags, data.AllowTags)
+	setKnownString(&config.DefaultView, data.DefaultView)
+	setKnownBool(&config.DisableNotifications, data.DisableNotifications)
+	setKnownBool(&config.Fivestar, data.Fivestar)
+	setKnownString(&config.FivestarLabel, data.FivestarLabel)
+	setKnownBool(&config.Thumbs, data.Thumbs)
+	setKnownString(&config.ThumbsLabel, data.ThumbsLabel)
+	setKnownBool(&config.Approved, data.Approved)
+	setKnownBool(&config.RSVP, data.RSVP)
+	setKnownString(&config.RSVPLabel, data.RSVPLabel)
+	setKnownBool(&config.YesNo, data.YesNo)
+	setKnownString(&config.YesNoLabel, data.YesNoLabel)
+	setKnownBool(&config.ShowAppItemID, data.ShowAppItemID)
+	setKnownString(&config.AppItemIDPrefix, data.AppItemIDPrefix)
+
+	if !data.Tasks.Null && !data.Tasks.Unknown {
+		diags.Append(data.Tasks.ElementsAs(ctx, &config.Tasks, false)...)
+	}
+
+	if !data.CalendarColorCategoryField.Null && !data.CalendarColorCategoryField.Unknown {
+		config.CalendarColorCategoryField = int(data.CalendarColorCategoryField.Value)
+	}
+
+	return config, diags
+}
+
+// setKnownString sets the config value if the attribute is known.
+func setKnownString(value *string, attribute types.String) {
+	if !attribute.Null && !attribute.Unknown {
+		*value = attribute.Value
+	}
+}
+
+// setKnownBool sets the config value if the attribute is known.
+func setKnownBool(value *bool, attribute types.Bool) {
+	if !attribute.Null && !attribute.Unknown {
+		*value = attribute.Value
+	}
+}
+
+// setApp copies the app returned by Podio into the Terraform data.
+func (data *appResourceData) setApp(app *podio.App) {
+	data.AppID = types.Int64{Value: int64(app.AppID)}
+	data.SpaceID = types.Int64{Value: int64(app.SpaceID)}
+	data.Active = types.Bool{Value: app.Status == "active"}
+
+	config := newAppConfigData(app.Config)
+	data.Name = config.Name
+	data.Type = config.Type
+	data.ItemName = config.ItemName
+	data.Description = config.Description
+	data.Usage = config.Usage
+	data.Icon = config.Icon
+	data.ExternalID = config.ExternalID
+	data.AllowEdit = config.AllowEdit
+	data.AllowAttachments = config.AllowAttachments
+	data.AllowComments = config.AllowComments
+	data.AllowCreate = config.AllowCreate
+	data.AllowTags = config.AllowTags
+	data.SilentCreates = config.SilentCreates
+	data.SilentEdits = config.SilentEdits
+	data.DefaultView = config.DefaultView
+	data.DisableNotifications = config.DisableNotifications
+	data.Fivestar = config.Fivestar
+	data.FivestarLabel = config.FivestarLabel
+	data.Thumbs = config.Thumbs
+	data.ThumbsLabel = config.ThumbsLabel
+	data.Approved = config.Approved
+	data.RSVP = config.RSVP
+	data.RSVPLabel = config.RSVPLabel
+	data.YesNo = config.YesNo
+	data.YesNoLabel = config.YesNoLabel
+	data.Tasks = config.Tasks
+	data.ShowAppItemID = config.ShowAppItemID
+	data.AppItemIDPrefix = config.AppItemIDPrefix
+	data.CalendarColorCategoryField = config.CalendarColorCategoryField
+}
+
+// appConfigData holds the config of an app as the values of the podio_app
+// attributes, for podio_app and the podio_app data source.
+type appConfigData struct {
+	Name                       types.String
+	Type                       types.String
+	ItemName                   types.String
+	Description                types.String
+	Usage                      types.String
+	Icon                       types.String
+	ExternalID                 types.String
+	AllowEdit                  types.Bool
+	AllowAttachments           types.Bool
+	AllowComments              types.Bool
+	AllowCreate                types.Bool
+	AllowTags                  types.Bool
+	SilentCreates              types.Bool
+	SilentEdits                types.Bool
+	DefaultView                types.String
+	DisableNotifications       types.Bool
+	Fivestar                   types.Bool
+	FivestarLabel              types.String
+	Thumbs                     types.Bool
+	ThumbsLabel                types.String
+	Approved                   types.Bool
+	RSVP                       types.Bool
+	RSVPLabel                  types.String
+	YesNo                      types.Bool
+	YesNoLabel                 types.String
+	Tasks                      types.List
+	ShowAppItemID              types.Bool
+	AppItemIDPrefix            types.String
+	CalendarColorCategoryField types.Int64
+}
+
+// newAppConfigData converts the config of an app returned by Podio.
+func newAppConfigData(config podio.AppConfig) appConfigData {
+	data := appConfigData{
+		Name:                       types.String{Value: config.Name},
+		Type:                       types.String{Value: config.Type},
+		ItemName:                   types.String{Value: config.ItemName},
+		Description:                types.String{Value: config.Description},
+		Usage:                      types.String{Value: config.Usage},
+		Icon:                       types.String{Value: config.Icon},
+		ExternalID:                 types.String{Value: config.ExternalID},
+		AllowEdit:                  types.Bool{Value: config.AllowEdit},
+		AllowAttachments:           types.Bool{Value: config.AllowAttachments},
+		AllowComments:              types.Bool{Value: config.AllowComments},
+		AllowCreate:                types.Bool{Value: config.AllowCreate},
+		AllowTags:                  types.Bool{Value: config.AllowTags},
+		SilentCreates:              types.Bool{Value: config.SilentCreates},
+		SilentEdits:                types.Bool{Value: config.SilentEdits},
+		DefaultView:                types.String{Value: config.DefaultView},
+		DisableNotifications:       types.Bool{Value: config.DisableNotifications},
+		Fivestar:                   types.Bool{Value: config.Fivestar},
+		FivestarLabel:              types.String{Value: config.FivestarLabel},
+		Thumbs:                     types.Bool{Value: config.Thumbs},
+		ThumbsLabel:                types.String{Value: config.ThumbsLabel},
+		Approved:                   types.Bool{Value: config.Approved},
+		RSVP:                       types.Bool{Value: config.RSVP},
+		RSVPLabel:                  types.String{Value: config.RSVPLabel},
+		YesNo:                      types.Bool{Value: config.YesNo},
+		YesNoLabel:                 types.String{Value: config.YesNoLabel},
+		ShowAppItemID:              types.Bool{Value: config.ShowAppItemID},
+		AppItemIDPrefix:            types.String{Value: config.AppItemIDPrefix},
+		CalendarColorCategoryField: types.Int64{Value: int64(config.CalendarColorCategoryField)},
+		Tasks:                      types.List{ElemType: types.StringType, Elems: []attr.Value{}},
+	}
+
+	for _, task := range config.Tasks {
+		data.Tasks.Elems = append(data.Tasks.Elems, types.String{Value: task})
+	}
+
+	return data
+}
+
+// setFields refreshes the fields managed by `field` blocks from the fields
+// Podio returned for the app. Fields that no longer exist are dropped so they
+// get created again on the next apply.
+func (data *appResourceData) setFields(fields []podio.AppField) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	remote := map[string]podio.AppField{}
+	for _, field := range fields {
+		if field.Status == "deleted" {
+			continue
+		}
+
+		remote[field.ExternalID] = field
+	}
+
+	blocks := []appFieldBlockData{}
+	data.FieldIDs = types.Map{ElemType: types.Int64Type, Elems: map[string]attr.Value{}}
+
+	for _, block := range data.Fields {
+		field, ok := remote[block.ExternalID.Value]
+		if !ok {
+			continue
+		}
+
+		block.Type = types.String{Value: field.Type}
+		block.Label = types.String{Value: field.Config.Label}
+
+		if !block.Description.Null || field.Config.Description != "" {
+			block.Description = types.String{Value: field.Config.Description}
+		}
+
+		if !block.Required.Null || field.Config.Required {
+			block.Required = types.Bool{Value: field.Config.Required}
+		}
+
+		config, err := appFieldSettingsValue(block.Config, field.Config.Settings)
+		if err != nil {
+			diags.AddError("Invalid field config", fmt.Sprintf("Unable to encode config of field %s: %s", field.ExternalID, err))
+		}
+		block.Config = config
+
+		blocks = append(blocks, block)
+		data.FieldIDs.Elems[field.ExternalID] = types.Int64{Value: int64(field.FieldID)}
+	}
+
+	data.Fields = blocks
+
+	return diags
 }
 
 type appResource struct {
 	provider provider
 }
 
-func (r appResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+func (r appResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
 	var data appResourceData
 
 	diags := req.Config.Get(ctx, &data)
@@ -149,44 +702,492 @@ func (r appResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest
 		return
 	}
 
-	app, err := r.provider.client.CreateApplication(
-		strconv.Itoa(int(data.SpaceID.Value)),
-		podio.CreateApplicationParams{
-			Config: podio.AppConfig{
-				Name:             data.Name.Value,
-				Type:             data.Type.Value,
-				ItemName:         data.ItemName.Value,
-				Description:      data.Description.Value,
-				Usage:            data.Usage.Value,
-				Icon:             data.Icon.Value,
-				AllowEdit:        data.AllowEdit.Value,
-				AllowAttachments: data.AllowAttachments.Value,
-				AllowComments:    data.AllowComments.Value,
-				SilentCreates:    data.SilentCreates.Value,
-				SilentEdits:      data.SilentEdits.Value,
+	seen := map[string]bool{}
+	for i, field := range data.Fields {
+		if field.ExternalID.Null || field.ExternalID.Unknown {
+			continue
+		}
+
+		if seen[field.ExternalID.Value] {
+			resp.Diagnostics.AddAttributeError(
+				tftypes.NewAttributePath().WithAttributeName("field").WithElementKeyInt(i).WithAttributeName("external_id"),
+				"Duplicate field external_id",
+				fmt.Sprintf("The external_id %q is used by more than one field. Fields are matched by external_id, so it must be unique within the app.", field.ExternalID.Value),
+			)
+		}
+
+		seen[field.ExternalID.Value] = true
+	}
+}
+
+// syncFields reconciles the fields of an app with the planned `field` blocks,
+// matching them with the prior state by external_id. Fields that changed type
+// are deleted and created again, as Podio can't convert between field types.
+func (r appResource) syncFields(ctx context.Context, appID string, prior appResourceData, planned []appFieldBlockData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	priorFields := map[string]appFieldBlockData{}
+	for _, field := range prior.Fields {
+		priorFields[field.ExternalID.Value] = field
+	}
+
+	priorIDs := map[string]string{}
+	for externalID, value := range prior.FieldIDs.Elems {
+		if id, ok := value.(types.Int64); ok {
+			priorIDs[externalID] = strconv.Itoa(int(id.Value))
+		}
+	}
+
+	plannedTypes := map[string]string{}
+	for _, field := range planned {
+		plannedTypes[field.ExternalID.Value] = field.Type.Value
+	}
+
+	// Delete removed fields first so their external_ids can be reused.
+	for externalID, fieldID := range priorIDs {
+		if _, ok := plannedTypes[externalID]; ok {
+			continue
+		}
+
+		diags.Append(r.checkFieldDataLoss(appID, fieldID, priorFields[externalID])...)
+		if diags.HasError() {
+			return diags
+		}
+
+		err := r.provider.client.DeleteAppField(appID, fieldID)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete field %s: %s", externalID, err))
+			return diags
+		}
+
+		tflog.Trace(ctx, "deleted a field in Podio")
+	}
+
+	for i, field := range planned {
+		settings, err := appFieldSettings(field.Config)
+		if err != nil {
+			diags.AddError("Invalid field config", fmt.Sprintf("Unable to decode config of field %s: %s", field.ExternalID.Value, err))
+			return diags
+		}
+
+		config := podio.AppFieldConfig{
+			Label:       field.Label.Value,
+			Description: field.Description.Value,
+			Required:    field.Required.Value,
+			Delta:       i,
+			Settings:    settings,
+		}
+
+		externalID := field.ExternalID.Value
+		fieldID, exists := priorIDs[externalID]
+
+		if exists && priorFields[externalID].Type.Value == field.Type.Value {
+			_, err := r.provider.client.UpdateAppField(appID, fieldID, config)
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to update field %s: %s", externalID, err))
+				return diags
+			}
+
+			continue
+		}
+
+		if exists {
+			diags.Append(r.checkFieldDataLoss(appID, fieldID, priorFields[externalID])...)
+			if diags.HasError() {
+				return diags
+			}
+
+			err := r.provider.client.DeleteAppField(appID, fieldID)
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to delete field %s: %s", externalID, err))
+				return diags
+			}
+
+			tflog.Trace(ctx, "deleted a field in Podio")
+		}
+
+		_, err = r.provider.client.CreateAppField(appID, podio.CreateAppFieldParams{
+			Type:       field.Type.Value,
+			ExternalID: externalID,
+			Config:     config,
+		})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to create field %s: %s", externalID, err))
+			return diags
+		}
+
+		tflog.Trace(ctx, "created a field in Podio")
+	}
+
+	return diags
+}
+
+// checkFieldDataLoss returns an error if the field has values on any item,
+// unless the `allow_data_loss` of its block was true.
+func (r appResource) checkFieldDataLoss(appID, fieldID string, field appFieldBlockData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if field.AllowDataLoss.Value {
+		return diags
+	}
+
+	count, err := r.provider.client.CountItemsWithFieldValues(appID, fieldID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to count the items with values for field %s: %s", field.ExternalID.Value, err))
+		return diags
+	}
+
+	if count > 0 {
+		diags.AddError(
+			"Field has values",
+			fmt.Sprintf("Field %q (%s) has values on %d items, which would be deleted along with it. Set `allow_data_loss = true` in its block and apply before removing it or changing its type.", field.ExternalID.Value, fieldID, count),
+		)
+	}
+
+	return diags
+}
+
+// fieldBlocksChanged reports whether the plan adds or removes a `field` block
+// or changes its type, which creates a field with a new ID.
+func fieldBlocksChanged(state, plan appResourceData) bool {
+	if len(state.Fields) != len(plan.Fields) {
+		return true
+	}
+
+	stateTypes := map[string]string{}
+	for _, field := range state.Fields {
+		stateTypes[field.ExternalID.Value] = field.Type.Value
+	}
+
+	for _, field := range plan.Fields {
+		if field.ExternalID.Unknown || field.Type.Unknown {
+			return true
+		}
+
+		if typ, ok := stateTypes[field.ExternalID.Value]; !ok || typ != field.Type.Value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// warnFieldDataLoss adds a warning to the plan for every field of a `field`
+// block that is removed or changes type while it has values on any item.
+func (r appResource) warnFieldDataLoss(state, plan appResourceData, resp *tfsdk.ModifyResourcePlanResponse) {
+	plannedTypes := map[string]types.String{}
+	for _, field := range plan.Fields {
+		if field.ExternalID.Unknown {
+			return
+		}
+
+		plannedTypes[field.ExternalID.Value] = field.Type
+	}
+
+	for _, field := range state.Fields {
+		plannedType, kept := plannedTypes[field.ExternalID.Value]
+		if kept && (plannedType.Unknown || plannedType.Value == field.Type.Value) {
+			continue
+		}
+
+		id, ok := state.FieldIDs.Elems[field.ExternalID.Value].(types.Int64)
+		if !ok {
+			continue
+		}
+
+		count, err := r.provider.client.CountItemsWithFieldValues(
+			strconv.Itoa(int(state.AppID.Value)),
+			strconv.Itoa(int(id.Value)),
+		)
+		if err != nil {
+			resp.Diagnostics.AddWarning("Unable to check for data loss", fmt.Sprintf("Unable to count the items with values for field %s: %s", field.ExternalID.Value, err))
+			continue
+		}
+
+		if count == 0 {
+			continue
+		}
+
+		action := "Removing"
+		if kept {
+			action = "Changing the type of"
+		}
+
+		detail := fmt.Sprintf("%s field %q (%d) deletes its values from %d items in app %d.", action, field.ExternalID.Value, id.Value, count, state.AppID.Value)
+		if !field.AllowDataLoss.Value {
+			detail += " The apply will fail unless `allow_data_loss = true` is applied to its block first."
+		}
+
+		resp.Diagnostics.AddWarning("Field values will be deleted", detail)
+	}
+}
+
+// findDeactivatedApp returns the inactive app in the space with the same
+// external_id, which is reactivated instead of creating a new app when
+// `on_destroy` is `deactivate`. It returns nil if there is none.
+func (r appResource) findDeactivatedApp(data appResourceData) (*podio.App, error) {
+	if data.OnDestroy.Value != "deactivate" || data.ExternalID.Null || data.ExternalID.Unknown || data.ExternalID.Value == "" {
+		return nil, nil
+	}
+
+	apps, err := r.provider.client.GetSpaceApplications(strconv.Itoa(int(data.SpaceID.Value)), true)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, app := range apps {
+		if app.Status == "inactive" && app.Config.ExternalID == data.ExternalID.Value {
+			return r.provider.client.GetApplication(strconv.Itoa(app.AppID))
+		}
+	}
+
+	return nil, nil
+}
+
+// ModifyPlan warns about fields of `field` blocks whose values would be
+// deleted, and fills the config attributes that aren't configured from the
+// template, so the plan shows what the app will look like.
+func (r appResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan appResourceData
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state appResourceData
+
+	if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		r.warnFieldDataLoss(state, plan, resp)
+
+		if fieldBlocksChanged(state, plan) {
+			plan.FieldIDs = types.Map{ElemType: types.Int64Type, Unknown: true}
+
+			diags = resp.Plan.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("field_ids"), plan.FieldIDs)
+			resp.Diagnostics.Append(diags...)
+		}
+	}
+
+	var config appResourceData
+
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || config.TemplateJSON.Null || config.TemplateJSON.Unknown {
+		return
+	}
+
+	template, err := parseAppTemplate(config.TemplateJSON.Value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("template_json"),
+			"Invalid app template",
+			fmt.Sprintf("Unable to decode the app template: %s", err),
+		)
+		return
+	}
+
+	// The calendar color field of the template may already exist in the app.
+	fieldIDs := map[string]int{}
+	if template.Config.CalendarColorCategoryField != "" && config.CalendarColorCategoryField.Null && !req.State.Raw.IsNull() {
+		app, err := r.provider.client.GetApplication(strconv.Itoa(int(state.AppID.Value)))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+			return
+		}
+
+		for _, field := range sortedAppFields(app.Fields) {
+			fieldIDs[field.ExternalID] = field.FieldID
+		}
+	}
+
+	template.Config.merge(config, &plan, fieldIDs)
+
+	diags = resp.Plan.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// applyTemplate applies the fields, views and hooks of the template to the
+// app, leaving the fields defined in `field` blocks alone.
+func (r appResource) applyTemplate(ctx context.Context, appID string, data appResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	template, err := parseAppTemplate(data.TemplateJSON.Value)
+	if err != nil {
+		diags.AddError("Invalid app template", fmt.Sprintf("Unable to decode the app template: %s", err))
+		return diags
+	}
+
+	skip := map[string]bool{}
+	for _, field := range data.Fields {
+		skip[field.ExternalID.Value] = true
+	}
+
+	return applyAppTemplate(ctx, r.provider.client, appID, template, skip)
+}
+
+// setTemplateCalendarField sets the calendar color field of the app to the
+// one from the template, which can only be done once the template has
+// created the field.
+func (r appResource) setTemplateCalendarField(ctx context.Context, appID string, data appResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	template, err := parseAppTemplate(data.TemplateJSON.Value)
+	if err != nil {
+		diags.AddError("Invalid app template", fmt.Sprintf("Unable to decode the app template: %s", err))
+		return diags
+	}
+
+	externalID := template.Config.CalendarColorCategoryField
+	if externalID == "" {
+		return diags
+	}
+
+	app, err := r.provider.client.GetApplication(appID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return diags
+	}
+
+	for _, field := range sortedAppFields(app.Fields) {
+		if field.ExternalID != externalID {
+			continue
+		}
+
+		config := app.Config
+		config.CalendarColorCategoryField = field.FieldID
+
+		_, err = r.provider.client.UpdateApplication(appID, podio.CreateApplicationParams{
+			Config: config,
+		})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update app: %s", err))
+		}
+
+		return diags
+	}
+
+	diags.AddError("Unknown field", fmt.Sprintf("The calendar color field %q of the app template doesn't exist in app %s", externalID, appID))
+	return diags
+}
+
+func (r appResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data appResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, diags := data.appConfig(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := r.findDeactivatedApp(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list apps: %s", err))
+		return
+	}
+
+	// The prior fields are those of the reactivated app, so that fields with
+	// the same external_id are updated instead of created again.
+	prior := appResourceData{}
+	reactivated := app != nil
+
+	if reactivated {
+		prior = data
+		diags = prior.setFields(app.Fields)
+		resp.Diagnostics.Append(diags...)
+
+		app, err = r.provider.client.UpdateApplication(
+			strconv.Itoa(app.AppID),
+			podio.CreateApplicationParams{
+				Config: config,
 			},
-		},
-	)
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update app: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "reused a deactivated app in Podio")
+	} else {
+		app, err = r.provider.client.CreateApplication(
+			strconv.Itoa(int(data.SpaceID.Value)),
+			podio.CreateApplicationParams{
+				Config: config,
+			},
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create app: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "created an app in Podio")
+	}
+
+	appID := strconv.Itoa(app.AppID)
+
+	// New apps are active, so only reactivated apps need their status set.
+	if reactivated && (data.Active.Null || data.Active.Unknown || data.Active.Value) {
+		err = r.provider.client.ActivateApplication(appID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to activate app: %s", err))
+			return
+		}
+	} else if !data.Active.Null && !data.Active.Unknown && !data.Active.Value {
+		err = r.provider.client.DeactivateApplication(appID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate app: %s", err))
+			return
+		}
+	}
+
+	calendarUnknown := data.CalendarColorCategoryField.Unknown
+
+	data.setApp(app)
+
+	diags = r.syncFields(ctx, appID, prior, data.Fields)
+	resp.Diagnostics.Append(diags...)
+
+	if !data.TemplateJSON.Null {
+		diags = r.applyTemplate(ctx, appID, data)
+		resp.Diagnostics.Append(diags...)
+
+		if calendarUnknown && !resp.Diagnostics.HasError() {
+			diags = r.setTemplateCalendarField(ctx, appID, data)
+			resp.Diagnostics.Append(diags...)
+		}
+	}
+
+	app, err = r.provider.client.GetApplication(strconv.Itoa(app.AppID))
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create app: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
 		return
 	}
 
-	data.AppID = types.Int64{Value: int64(app.AppID)}
-	data.SpaceID = types.Int64{Value: int64(app.SpaceID)}
-	data.Name = types.String{Value: app.Config.Name}
-	data.Type = types.String{Value: app.Config.Type}
-	data.ItemName = types.String{Value: app.Config.ItemName}
-	data.Description = types.String{Value: app.Config.Description}
-	data.Usage = types.String{Value: app.Config.Usage}
-	data.Icon = types.String{Value: app.Config.Icon}
-	data.AllowEdit = types.Bool{Value: app.Config.AllowEdit}
-	data.AllowAttachments = types.Bool{Value: app.Config.AllowAttachments}
-	data.AllowComments = types.Bool{Value: app.Config.AllowComments}
-	data.SilentCreates = types.Bool{Value: app.Config.SilentCreates}
-	data.SilentEdits = types.Bool{Value: app.Config.SilentEdits}
-
-	tflog.Trace(ctx, "created a space in Podio")
+	data.setApp(app)
+
+	diags = data.setFields(app.Fields)
+	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -211,19 +1212,10 @@ func (r appResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, re
 		return
 	}
 
-	data.AppID = types.Int64{Value: int64(app.AppID)}
-	data.SpaceID = types.Int64{Value: int64(app.SpaceID)}
-	data.Name = types.String{Value: app.Config.Name}
-	data.Type = types.String{Value: app.Config.Type}
-	data.ItemName = types.String{Value: app.Config.ItemName}
-	data.Description = types.String{Value: app.Config.Description}
-	data.Usage = types.String{Value: app.Config.Usage}
-	data.Icon = types.String{Value: app.Config.Icon}
-	data.AllowEdit = types.Bool{Value: app.Config.AllowEdit}
-	data.AllowAttachments = types.Bool{Value: app.Config.AllowAttachments}
-	data.AllowComments = types.Bool{Value: app.Config.AllowComments}
-	data.SilentCreates = types.Bool{Value: app.Config.SilentCreates}
-	data.SilentEdits = types.Bool{Value: app.Config.SilentEdits}
+	data.setApp(app)
+
+	diags = data.setFields(app.Fields)
+	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -235,6 +1227,18 @@ func (r appResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest
 	diags := req.Plan.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
+	var state appResourceData
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, diags := data.appConfig(ctx)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -242,19 +1246,7 @@ func (r appResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest
 	app, err := r.provider.client.UpdateApplication(
 		strconv.Itoa(int(data.AppID.Value)),
 		podio.CreateApplicationParams{
-			Config: podio.AppConfig{
-				Name:             data.Name.Value,
-				Type:             data.Type.Value,
-				ItemName:         data.ItemName.Value,
-				Description:      data.Description.Value,
-				Usage:            data.Usage.Value,
-				Icon:             data.Icon.Value,
-				AllowEdit:        data.AllowEdit.Value,
-				AllowAttachments: data.AllowAttachments.Value,
-				AllowComments:    data.AllowComments.Value,
-				SilentCreates:    data.SilentCreates.Value,
-				SilentEdits:      data.SilentEdits.Value,
-			},
+			Config: config,
 		},
 	)
 
@@ -263,19 +1255,42 @@ func (r appResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest
 		return
 	}
 
-	data.AppID = types.Int64{Value: int64(app.AppID)}
-	data.SpaceID = types.Int64{Value: int64(app.SpaceID)}
-	data.Name = types.String{Value: app.Config.Name}
-	data.Type = types.String{Value: app.Config.Type}
-	data.ItemName = types.String{Value: app.Config.ItemName}
-	data.Description = types.String{Value: app.Config.Description}
-	data.Usage = types.String{Value: app.Config.Usage}
-	data.Icon = types.String{Value: app.Config.Icon}
-	data.AllowEdit = types.Bool{Value: app.Config.AllowEdit}
-	data.AllowAttachments = types.Bool{Value: app.Config.AllowAttachments}
-	data.AllowComments = types.Bool{Value: app.Config.AllowComments}
-	data.SilentCreates = types.Bool{Value: app.Config.SilentCreates}
-	data.SilentEdits = types.Bool{Value: app.Config.SilentEdits}
+	if !data.Active.Null && !data.Active.Unknown && data.Active.Value != state.Active.Value {
+		if data.Active.Value {
+			err = r.provider.client.ActivateApplication(strconv.Itoa(app.AppID))
+		} else {
+			err = r.provider.client.DeactivateApplication(strconv.Itoa(app.AppID))
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change app status: %s", err))
+			return
+		}
+	}
+
+	diags = r.syncFields(ctx, strconv.Itoa(app.AppID), state, data.Fields)
+	resp.Diagnostics.Append(diags...)
+
+	if !data.TemplateJSON.Null && !data.TemplateJSON.Equal(state.TemplateJSON) {
+		diags = r.applyTemplate(ctx, strconv.Itoa(app.AppID), data)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if !data.TemplateJSON.Null && data.CalendarColorCategoryField.Unknown && !resp.Diagnostics.HasError() {
+		diags = r.setTemplateCalendarField(ctx, strconv.Itoa(app.AppID), data)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	app, err = r.provider.client.GetApplication(strconv.Itoa(app.AppID))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return
+	}
+
+	data.setApp(app)
+
+	diags = data.setFields(app.Fields)
+	resp.Diagnostics.Append(diags...)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -291,6 +1306,20 @@ func (r appResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest
 		return
 	}
 
+	if data.OnDestroy.Value == "deactivate" {
+		err := r.provider.client.DeactivateApplication(
+			strconv.Itoa(int(data.AppID.Value)),
+		)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate app: %s", err))
+			return
+		}
+
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	err := r.provider.client.DeleteApplication(
 		strconv.Itoa(int(data.AppID.Value)),
 	)
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kayteh/podio-go"
+)
+
+func TestSetAppConfig(t *testing.T) {
+	app := &podio.App{
+		AppID:   7,
+		SpaceID: 3,
+		Status:  "active",
+		Config: podio.AppConfig{
+			Name:                       "Projects",
+			ItemName:                   "Project",
+			Icon:                       "12.png",
+			AllowTags:                  true,
+			Tasks:                      []string{"Kick-off"},
+			CalendarColorCategoryField: 42,
+		},
+	}
+
+	var resource appResourceData
+	resource.setApp(app)
+
+	var dataSource appDataSourceData
+	dataSource.setApp(app)
+
+	checks := []struct {
+		name     string
+		value    attr.Value
+		expected attr.Value
+	}{
+		{"name", resource.Name, types.String{Value: "Projects"}},
+		{"item_name", resource.ItemName, types.String{Value: "Project"}},
+		{"icon", resource.Icon, types.String{Value: "12.png"}},
+		{"allow_tags", resource.AllowTags, types.Bool{Value: true}},
+		{"allow_edit", resource.AllowEdit, types.Bool{Value: false}},
+		{"tasks", resource.Tasks, types.List{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "Kick-off"}}}},
+		{"calendar_color_category_field", resource.CalendarColorCategoryField, types.Int64{Value: 42}},
+		{"app_id", resource.AppID, types.Int64{Value: 7}},
+		{"data source name", dataSource.Name, types.String{Value: "Projects"}},
+		{"data source allow_tags", dataSource.AllowTags, types.Bool{Value: true}},
+		{"data source tasks", dataSource.Tasks, types.List{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "Kick-off"}}}},
+		{"data source calendar_color_category_field", dataSource.CalendarColorCategoryField, types.Int64{Value: 42}},
+	}
+
+	for _, check := range checks {
+		if !check.value.Equal(check.expected) {
+			t.Errorf("expected %s to be %v, got %v", check.name, check.expected, check.value)
+		}
+	}
+}
+
+func TestFieldBlocksChanged(t *testing.T) {
+	field := func(externalID, typ string) appFieldBlockData {
+		return appFieldBlockData{
+			ExternalID: types.String{Value: externalID},
+			Type:       types.String{Value: typ},
+		}
+	}
+
+	state := appResourceData{Fields: []appFieldBlockData{field("title", "text"), field("status", "category")}}
+
+	cases := []struct {
+		name     string
+		fields   []appFieldBlockData
+		expected bool
+	}{
+		{"unchanged", []appFieldBlockData{field("title", "text"), field("status", "category")}, false},
+		{"reordered", []appFieldBlockData{field("status", "category"), field("title", "text")}, false},
+		{"added", []appFieldBlockData{field("title", "text"), field("status", "category"), field("due", "date")}, true},
+		{"removed", []appFieldBlockData{field("title", "text")}, true},
+		{"renamed", []appFieldBlockData{field("title", "text"), field("state", "category")}, true},
+		{"type changed", []appFieldBlockData{field("title", "text"), field("status", "text")}, true},
+	}
+
+	for _, c := range cases {
+		if changed := fieldBlocksChanged(state, appResourceData{Fields: c.fields}); changed != c.expected {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, changed)
+		}
+	}
+}
+
+func TestAppConfigDefaults(t *testing.T) {
+	data := appResourceData{
+		Name:       types.String{Value: "Projects"},
+		Type:       types.String{Unknown: true},
+		AllowEdit:  types.Bool{Unknown: true},
+		AllowTags:  types.Bool{Value: false},
+		Fivestar:   types.Bool{Null: true},
+		Tasks:      types.List{ElemType: types.StringType, Unknown: true},
+		ExternalID: types.String{Unknown: true},
+	}
+
+	config, diags := data.appConfig(context.Background())
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if config.Name != "Projects" || config.Type != "standard" || config.ExternalID != "" {
+		t.Errorf("unexpected strings: name %q, type %q, external_id %q", config.Name, config.Type, config.ExternalID)
+	}
+
+	if !config.AllowEdit || config.AllowTags || config.Fivestar {
+		t.Errorf("unexpected flags: allow_edit %v, allow_tags %v, fivestar %v", config.AllowEdit, config.AllowTags, config.Fivestar)
+	}
+
+	if config.Tasks != nil {
+		t.Errorf("expected no tasks, got %v", config.Tasks)
+	}
+}
@@ -0,0 +1,691 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kayteh/podio-go"
+)
+
+// appTemplate is a portable definition of an app. It holds no IDs specific to
+// a Podio instance: fields are referenced by external_id, referenced apps by
+// their external_id and category options by their text.
+type appTemplate struct {
+	Config appTemplateConfig  `json:"config"`
+	Fields []appTemplateField `json:"fields"`
+	Views  []appTemplateView  `json:"views"`
+	Hooks  []appTemplateHook  `json:"hooks"`
+}
+
+// appTemplateConfig mirrors the config attributes of podio_app, using the
+// same names. The calendar color field is referenced by its external_id.
+type appTemplateConfig struct {
+	Name                 string   `json:"name"`
+	Type                 string   `json:"type"`
+	ItemName             string   `json:"item_name"`
+	Description          string   `json:"description"`
+	Usage                string   `json:"usage"`
+	Icon                 string   `json:"icon"`
+	ExternalID           string   `json:"external_id"`
+	AllowEdit            bool     `json:"allow_edit"`
+	AllowAttachments     bool     `json:"allow_attachments"`
+	AllowComments        bool     `json:"allow_comments"`
+	AllowCreate          bool     `json:"allow_create"`
+	AllowTags            bool     `json:"allow_tags"`
+	SilentCreates        bool     `json:"silent_creates"`
+	SilentEdits          bool     `json:"silent_edits"`
+	DefaultView          string   `json:"default_view"`
+	DisableNotifications bool     `json:"disable_notifications"`
+	Fivestar             bool     `json:"fivestar"`
+	FivestarLabel        string   `json:"fivestar_label"`
+	Thumbs               bool     `json:"thumbs"`
+	ThumbsLabel          string   `json:"thumbs_label"`
+	Approved             bool     `json:"approved"`
+	RSVP                 bool     `json:"rsvp"`
+	RSVPLabel            string   `json:"rsvp_label"`
+	YesNo                bool     `json:"yesno"`
+	YesNoLabel           string   `json:"yesno_label"`
+	Tasks                []string `json:"tasks"`
+	ShowAppItemID        bool     `json:"show_app_item_id"`
+	AppItemIDPrefix      string   `json:"app_item_id_prefix"`
+
+	CalendarColorCategoryField string `json:"calendar_color_category_field_external_id,omitempty"`
+}
+
+type appTemplateField struct {
+	Type        string                 `json:"type"`
+	ExternalID  string                 `json:"external_id"`
+	Label       string                 `json:"label"`
+	Description string                 `json:"description,omitempty"`
+	Required    bool                   `json:"required"`
+	Settings    map[string]interface{} `json:"settings"`
+}
+
+type appTemplateView struct {
+	Name          string                  `json:"name"`
+	Layout        string                  `json:"layout"`
+	Private       bool                    `json:"private"`
+	SortBy        string                  `json:"sort_by,omitempty"`
+	SortByField   string                  `json:"sort_by_field,omitempty"`
+	SortDesc      bool                    `json:"sort_desc"`
+	GroupingField string                  `json:"grouping_field,omitempty"`
+	VisibleFields []string                `json:"visible_fields,omitempty"`
+	Filters       []appTemplateViewFilter `json:"filters,omitempty"`
+}
+
+type appTemplateViewFilter struct {
+	Field   string   `json:"field"`
+	Options []string `json:"options,omitempty"`
+	From    string   `json:"from,omitempty"`
+	To      string   `json:"to,omitempty"`
+}
+
+type appTemplateHook struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// parseAppTemplate decodes the JSON of an app template.
+func parseAppTemplate(value string) (appTemplate, error) {
+	var template appTemplate
+
+	err := json.Unmarshal([]byte(value), &template)
+	return template, err
+}
+
+// newAppTemplate builds the template of an app from its fields, views and
+// hooks. Referenced apps are looked up to find their external_id. Filters
+// on items or users are left out, as they can't be expressed portably; a
+// warning is returned for each of them.
+func newAppTemplate(client *podio.Client, app *podio.App, views []podio.View, hooks []podio.Hook) (appTemplate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	template := appTemplate{
+		Config: appTemplateConfig{
+			Name:                 app.Config.Name,
+			Type:                 app.Config.Type,
+			ItemName:             app.Config.ItemName,
+			Description:          app.Config.Description,
+			Usage:                app.Config.Usage,
+			Icon:                 app.Config.Icon,
+			ExternalID:           app.Config.ExternalID,
+			AllowEdit:            app.Config.AllowEdit,
+			AllowAttachments:     app.Config.AllowAttachments,
+			AllowComments:        app.Config.AllowComments,
+			AllowCreate:          app.Config.AllowCreate,
+			AllowTags:            app.Config.AllowTags,
+			SilentCreates:        app.Config.SilentCreates,
+			SilentEdits:          app.Config.SilentEdits,
+			DefaultView:          app.Config.DefaultView,
+			DisableNotifications: app.Config.DisableNotifications,
+			Fivestar:             app.Config.Fivestar,
+			FivestarLabel:        app.Config.FivestarLabel,
+			Thumbs:               app.Config.Thumbs,
+			ThumbsLabel:          app.Config.ThumbsLabel,
+			Approved:             app.Config.Approved,
+			RSVP:                 app.Config.RSVP,
+			RSVPLabel:            app.Config.RSVPLabel,
+			YesNo:                app.Config.YesNo,
+			YesNoLabel:           app.Config.YesNoLabel,
+			Tasks:                append([]string{}, app.Config.Tasks...),
+			ShowAppItemID:        app.Config.ShowAppItemID,
+			AppItemIDPrefix:      app.Config.AppItemIDPrefix,
+		},
+		Fields: []appTemplateField{},
+		Views:  []appTemplateView{},
+		Hooks:  []appTemplateHook{},
+	}
+
+	fields := sortedAppFields(app.Fields)
+
+	externalIDs := map[int]string{}
+	options := map[int64]string{}
+	for _, field := range fields {
+		externalIDs[field.FieldID] = field.ExternalID
+
+		for _, option := range categoryOptions(field.Config.Settings) {
+			options[option.ID] = option.Text
+		}
+	}
+
+	template.Config.CalendarColorCategoryField = externalIDs[app.Config.CalendarColorCategoryField]
+
+	for _, field := range fields {
+		settings, err := appTemplateSettings(client, field, externalIDs)
+		if err != nil {
+			diags.AddError("Invalid field", fmt.Sprintf("Unable to add field %s to the template: %s", field.ExternalID, err))
+			continue
+		}
+
+		template.Fields = append(template.Fields, appTemplateField{
+			Type:        field.Type,
+			ExternalID:  field.ExternalID,
+			Label:       field.Config.Label,
+			Description: field.Config.Description,
+			Required:    field.Config.Required,
+			Settings:    settings,
+		})
+	}
+
+	for _, view := range views {
+		v := appTemplateView{
+			Name:          view.Name,
+			Layout:        view.Layout,
+			Private:       view.Private,
+			SortBy:        view.SortBy,
+			SortDesc:      view.SortDesc,
+			GroupingField: externalIDs[view.GroupingFieldID],
+		}
+
+		if fieldID, err := strconv.Atoi(view.SortBy); err == nil {
+			v.SortBy = ""
+			v.SortByField = externalIDs[fieldID]
+		}
+
+		for _, fieldID := range view.Fields {
+			if externalID, ok := externalIDs[fieldID]; ok {
+				v.VisibleFields = append(v.VisibleFields, externalID)
+			}
+		}
+
+		for _, f := range view.Filters {
+			fieldID, err := strconv.Atoi(f.Key)
+			externalID, ok := externalIDs[fieldID]
+
+			if err != nil || !ok || appViewFilterType(fieldTypeOf(fields, fieldID)) == "app" {
+				diags.AddWarning("Filter left out of template", fmt.Sprintf("The %s filter of view %q refers to items or users, which can't be expressed in a template.", f.Key, view.Name))
+				continue
+			}
+
+			filter := appTemplateViewFilter{
+				Field: externalID,
+				From:  f.From,
+				To:    f.To,
+			}
+
+			for _, value := range f.Values {
+				filter.Options = append(filter.Options, options[int64(value)])
+			}
+
+			v.Filters = append(v.Filters, filter)
+		}
+
+		template.Views = append(template.Views, v)
+	}
+
+	for _, hook := range hooks {
+		template.Hooks = append(template.Hooks, appTemplateHook{
+			Type: hook.Type,
+			URL:  hook.URL,
+		})
+	}
+
+	return template, diags
+}
+
+// fieldTypeOf returns the type of the field with the given ID.
+func fieldTypeOf(fields []podio.AppField, fieldID int) string {
+	for _, field := range fields {
+		if field.FieldID == fieldID {
+			return field.Type
+		}
+	}
+
+	return ""
+}
+
+// appTemplateSettings returns the settings of a field without IDs: category
+// options lose their ID and deleted options are dropped, referenced apps are
+// replaced by their external_id and their views by name, and the fields
+// referenced by calculation scripts by their external_id, given by field ID.
+func appTemplateSettings(client *podio.Client, field podio.AppField, externalIDs map[int]string) (map[string]interface{}, error) {
+	settings := map[string]interface{}{}
+	for key, value := range field.Config.Settings {
+		settings[key] = value
+	}
+
+	if field.Type == "category" {
+		options := []interface{}{}
+		for _, option := range categoryOptions(field.Config.Settings) {
+			if option.Status == "deleted" {
+				continue
+			}
+
+			options = append(options, map[string]interface{}{
+				"text":  option.Text,
+				"color": option.Color,
+			})
+		}
+
+		settings["options"] = options
+	}
+
+	if field.Type == "app" {
+		raw, _ := settings["referenced_apps"].([]interface{})
+		delete(settings, "referenced_apps")
+
+		referenced := []interface{}{}
+		views := map[string]interface{}{}
+		for _, value := range raw {
+			ref, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			appID, _ := ref["app_id"].(float64)
+			app, err := client.GetApplication(strconv.Itoa(int(appID)))
+			if err != nil {
+				return nil, err
+			}
+
+			referenced = append(referenced, app.Config.ExternalID)
+
+			viewID, _ := ref["view_id"].(float64)
+			if viewID == 0 {
+				continue
+			}
+
+			appViews, err := client.GetViews(strconv.Itoa(app.AppID))
+			if err != nil {
+				return nil, err
+			}
+
+			for _, view := range appViews {
+				if view.ViewID == int(viewID) {
+					views[app.Config.ExternalID] = view.Name
+				}
+			}
+		}
+
+		settings["referenced_app_external_ids"] = referenced
+		if len(views) > 0 {
+			settings["referenced_app_views"] = views
+		}
+	}
+
+	if script, ok := settings["script"].(string); ok && field.Type == "calculation" {
+		script, err := calculationScriptToTemplate(script, externalIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		settings["script"] = script
+	}
+
+	return settings, nil
+}
+
+// merge sets the config attributes of the planned app that aren't set in its
+// configuration to the values from the template. The name is always taken
+// from the configuration. The calendar color field is looked up in fieldIDs,
+// the IDs of the fields of the app by external_id, and is unknown if the
+// template has yet to create it.
+func (c appTemplateConfig) merge(config appResourceData, plan *appResourceData, fieldIDs map[string]int) {
+	mergeString := func(configured types.String, planned *types.String, value string) {
+		if configured.Null {
+			*planned = types.String{Value: value}
+		}
+	}
+
+	mergeBool := func(configured types.Bool, planned *types.Bool, value bool) {
+		if configured.Null {
+			*planned = types.Bool{Value: value}
+		}
+	}
+
+	mergeString(config.Type, &plan.Type, c.Type)
+	mergeString(config.ItemName, &plan.ItemName, c.ItemName)
+	mergeString(config.Description, &plan.Description, c.Description)
+	mergeString(config.Usage, &plan.Usage, c.Usage)
+	mergeString(config.Icon, &plan.Icon, c.Icon)
+	mergeString(config.ExternalID, &plan.ExternalID, c.ExternalID)
+	mergeBool(config.AllowEdit, &plan.AllowEdit, c.AllowEdit)
+	mergeBool(config.AllowAttachments, &plan.AllowAttachments, c.AllowAttachments)
+	mergeBool(config.AllowComments, &plan.AllowComments, c.AllowComments)
+	mergeBool(config.AllowCreate, &plan.AllowCreate, c.AllowCreate)
+	mergeBool(config.AllowTags, &plan.AllowTags, c.AllowTags)
+	mergeBool(config.SilentCreates, &plan.SilentCreates, c.SilentCreates)
+	mergeBool(config.SilentEdits, &plan.SilentEdits, c.SilentEdits)
+	mergeString(config.DefaultView, &plan.DefaultView, c.DefaultView)
+	mergeBool(config.DisableNotifications, &plan.DisableNotifications, c.DisableNotifications)
+	mergeBool(config.Fivestar, &plan.Fivestar, c.Fivestar)
+	mergeString(config.FivestarLabel, &plan.FivestarLabel, c.FivestarLabel)
+	mergeBool(config.Thumbs, &plan.Thumbs, c.Thumbs)
+	mergeString(config.ThumbsLabel, &plan.ThumbsLabel, c.ThumbsLabel)
+	mergeBool(config.Approved, &plan.Approved, c.Approved)
+	mergeBool(config.RSVP, &plan.RSVP, c.RSVP)
+	mergeString(config.RSVPLabel, &plan.RSVPLabel, c.RSVPLabel)
+	mergeBool(config.YesNo, &plan.YesNo, c.YesNo)
+	mergeString(config.YesNoLabel, &plan.YesNoLabel, c.YesNoLabel)
+	mergeBool(config.ShowAppItemID, &plan.ShowAppItemID, c.ShowAppItemID)
+	mergeString(config.AppItemIDPrefix, &plan.AppItemIDPrefix, c.AppItemIDPrefix)
+
+	if config.Tasks.Null {
+		plan.Tasks = types.List{ElemType: types.StringType, Elems: []attr.Value{}}
+		for _, task := range c.Tasks {
+			plan.Tasks.Elems = append(plan.Tasks.Elems, types.String{Value: task})
+		}
+	}
+
+	if config.CalendarColorCategoryField.Null {
+		plan.CalendarColorCategoryField = types.Int64{Value: 0}
+
+		if c.CalendarColorCategoryField != "" {
+			fieldID, ok := fieldIDs[c.CalendarColorCategoryField]
+			plan.CalendarColorCategoryField = types.Int64{Value: int64(fieldID), Unknown: !ok}
+		}
+	}
+}
+
+// applyAppTemplate creates or updates the fields, views and hooks of an app
+// from a template. Fields are matched by external_id, views by name and hooks
+// by URL and type. Nothing missing from the template is removed, and fields
+// listed in skip are left to the `field` blocks of podio_app.
+func applyAppTemplate(ctx context.Context, client *podio.Client, appID string, template appTemplate, skip map[string]bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	app, err := client.GetApplication(appID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return diags
+	}
+
+	remote := map[string]podio.AppField{}
+	scriptFieldIDs := map[string]int{}
+	for _, field := range sortedAppFields(app.Fields) {
+		remote[field.ExternalID] = field
+		scriptFieldIDs[field.ExternalID] = field.FieldID
+	}
+
+	spaceApps, err := client.GetSpaceApplications(strconv.Itoa(app.SpaceID), false)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list apps: %s", err))
+		return diags
+	}
+
+	// Calculation fields are applied last, so the fields their scripts
+	// reference exist.
+	indexes := []int{}
+	for i, field := range template.Fields {
+		if field.Type != "calculation" {
+			indexes = append(indexes, i)
+		}
+	}
+	for i, field := range template.Fields {
+		if field.Type == "calculation" {
+			indexes = append(indexes, i)
+		}
+	}
+
+	for _, i := range indexes {
+		field := template.Fields[i]
+		if skip[field.ExternalID] {
+			continue
+		}
+
+		settings, d := appTemplateFieldSettings(client, field, remote[field.ExternalID], spaceApps, scriptFieldIDs)
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		config := podio.AppFieldConfig{
+			Label:       field.Label,
+			Description: field.Description,
+			Required:    field.Required,
+			Delta:       i,
+			Settings:    settings,
+		}
+
+		existing, ok := remote[field.ExternalID]
+		if ok && existing.Type != field.Type {
+			diags.AddError("Incompatible field", fmt.Sprintf("Field %s is a %s field, but the template defines it as a %s field", field.ExternalID, existing.Type, field.Type))
+			return diags
+		}
+
+		if ok {
+			_, err = client.UpdateAppField(appID, strconv.Itoa(existing.FieldID), config)
+		} else {
+			var created *podio.AppField
+			created, err = client.CreateAppField(appID, podio.CreateAppFieldParams{
+				Type:       field.Type,
+				ExternalID: field.ExternalID,
+				Config:     config,
+			})
+			if created != nil {
+				scriptFieldIDs[field.ExternalID] = created.FieldID
+			}
+		}
+
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to apply field %s: %s", field.ExternalID, err))
+			return diags
+		}
+
+		tflog.Trace(ctx, "applied a template field in Podio")
+	}
+
+	// Fetch the fields again to resolve the views against the new field IDs.
+	app, err = client.GetApplication(appID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return diags
+	}
+
+	fieldIDs := map[string]int{}
+	options := map[string]map[string]int{}
+	for _, field := range sortedAppFields(app.Fields) {
+		fieldIDs[field.ExternalID] = field.FieldID
+
+		options[field.ExternalID] = map[string]int{}
+		for _, option := range categoryOptions(field.Config.Settings) {
+			options[field.ExternalID][option.Text] = int(option.ID)
+		}
+	}
+
+	views, err := client.GetViews(appID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get views: %s", err))
+		return diags
+	}
+
+	viewIDs := map[string]int{}
+	for _, view := range views {
+		viewIDs[view.Name] = view.ViewID
+	}
+
+	for _, view := range template.Views {
+		params := podio.CreateViewParams{
+			Name:            view.Name,
+			Private:         view.Private,
+			Layout:          view.Layout,
+			SortBy:          view.SortBy,
+			SortDesc:        view.SortDesc,
+			GroupingFieldID: fieldIDs[view.GroupingField],
+			Fields:          []int{},
+			Filters:         []podio.ViewFilter{},
+		}
+
+		if view.SortByField != "" {
+			params.SortBy = strconv.Itoa(fieldIDs[view.SortByField])
+		}
+
+		for _, externalID := range view.VisibleFields {
+			params.Fields = append(params.Fields, fieldIDs[externalID])
+		}
+
+		for _, filter := range view.Filters {
+			f := podio.ViewFilter{
+				Key:    strconv.Itoa(fieldIDs[filter.Field]),
+				Values: []int{},
+				From:   filter.From,
+				To:     filter.To,
+			}
+
+			for _, text := range filter.Options {
+				f.Values = append(f.Values, options[filter.Field][text])
+			}
+
+			params.Filters = append(params.Filters, f)
+		}
+
+		if viewID, ok := viewIDs[view.Name]; ok {
+			_, err = client.UpdateView(strconv.Itoa(viewID), params)
+		} else {
+			_, err = client.CreateView(appID, params)
+		}
+
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to apply view %q: %s", view.Name, err))
+			return diags
+		}
+
+		tflog.Trace(ctx, "applied a template view in Podio")
+	}
+
+	hooks, err := client.GetHooks("app", appID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get hooks: %s", err))
+		return diags
+	}
+
+	existingHooks := map[appTemplateHook]bool{}
+	for _, hook := range hooks {
+		existingHooks[appTemplateHook{Type: hook.Type, URL: hook.URL}] = true
+	}
+
+	for _, hook := range template.Hooks {
+		if existingHooks[hook] {
+			continue
+		}
+
+		_, err = client.CreateHook("app", appID, podio.CreateHookParams{
+			URL:  hook.URL,
+			Type: hook.Type,
+		})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to create hook for %s: %s", hook.URL, err))
+			return diags
+		}
+
+		tflog.Trace(ctx, "created a template hook in Podio")
+	}
+
+	return diags
+}
+
+// appTemplateFieldSettings turns the settings of a template field back into
+// Podio settings. Category options keep the IDs of the existing options with
+// the same text, referenced apps are looked up by external_id within the
+// space of the app and their views by name, and the fields referenced by
+// calculation scripts are looked up in fieldIDs by external_id.
+func appTemplateFieldSettings(client *podio.Client, field appTemplateField, existing podio.AppField, spaceApps []podio.App, fieldIDs map[string]int) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	settings := map[string]interface{}{}
+	for key, value := range field.Settings {
+		settings[key] = value
+	}
+
+	if field.Type == "category" {
+		optionIDs := map[string]int64{}
+		for _, option := range categoryOptions(existing.Config.Settings) {
+			optionIDs[option.Text] = option.ID
+		}
+
+		raw, _ := settings["options"].([]interface{})
+
+		options := []interface{}{}
+		for _, value := range raw {
+			option, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			text, _ := option["text"].(string)
+			if id, ok := optionIDs[text]; ok {
+				option["id"] = id
+			}
+
+			options = append(options, option)
+		}
+
+		settings["options"] = options
+	}
+
+	if field.Type == "app" {
+		raw, _ := settings["referenced_app_external_ids"].([]interface{})
+		delete(settings, "referenced_app_external_ids")
+
+		viewNames, _ := settings["referenced_app_views"].(map[string]interface{})
+		delete(settings, "referenced_app_views")
+
+		appIDs := map[string]int{}
+		for _, app := range spaceApps {
+			appIDs[app.Config.ExternalID] = app.AppID
+		}
+
+		referenced := []interface{}{}
+		for _, value := range raw {
+			externalID, _ := value.(string)
+
+			appID, ok := appIDs[externalID]
+			if !ok {
+				diags.AddError("Unknown app", fmt.Sprintf("Field %s references app %q, which doesn't exist in the space", field.ExternalID, externalID))
+				continue
+			}
+
+			ref := map[string]interface{}{
+				"app_id": appID,
+			}
+
+			if viewName, _ := viewNames[externalID].(string); viewName != "" {
+				viewID, err := appViewIDByName(client, appID, viewName)
+				if err != nil {
+					diags.AddError("Unknown view", fmt.Sprintf("Field %s references view %q of app %q: %s", field.ExternalID, viewName, externalID, err))
+					continue
+				}
+
+				ref["view_id"] = viewID
+			}
+
+			referenced = append(referenced, ref)
+		}
+
+		settings["referenced_apps"] = referenced
+	}
+
+	if script, ok := settings["script"].(string); ok && field.Type == "calculation" {
+		script, err := calculationScriptFromTemplate(script, fieldIDs)
+		if err != nil {
+			diags.AddError("Invalid calculation", fmt.Sprintf("Unable to apply the script of field %s: %s", field.ExternalID, err))
+		}
+
+		settings["script"] = script
+	}
+
+	return settings, diags
+}
+
+// appViewIDByName returns the ID of the view of the app with the given name.
+func appViewIDByName(client *podio.Client, appID int, name string) (int, error) {
+	views, err := client.GetViews(strconv.Itoa(appID))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, view := range views {
+		if view.Name == name {
+			return view.ViewID, nil
+		}
+	}
+
+	return 0, fmt.Errorf("the app has no view named %q", name)
+}
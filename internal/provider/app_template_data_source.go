@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = appTemplateDataSourceType{}
+var _ tfsdk.DataSource = appTemplateDataSource{}
+
+type appTemplateDataSourceType struct{}
+
+func (t appTemplateDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "A portable definition of an app, its fields, views and hooks, to be used as the `template_json` of a `podio_app`",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"app_id": {
+				Type:        types.Int64Type,
+				Description: "The numeric ID of the app.",
+				Required:    true,
+			},
+			"template_json": {
+				Type:        types.StringType,
+				Description: "JSON encoded template of the app. It contains no IDs: fields are referenced by external ID, also within calculation scripts, referenced apps by their external ID, their views by name and category options by their text.",
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (t appTemplateDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return appTemplateDataSource{
+		provider: provider,
+	}, diags
+}
+
+type appTemplateDataSourceData struct {
+	AppID        types.Int64  `tfsdk:"app_id"`
+	TemplateJSON types.String `tfsdk:"template_json"`
+}
+
+type appTemplateDataSource struct {
+	provider provider
+}
+
+func (d appTemplateDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data appTemplateDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := fmt.Sprintf("%d", data.AppID.Value)
+
+	app, err := d.provider.client.GetApplication(appID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching app", fmt.Sprintf("Unable to fetch app, got error: %s", err))
+		return
+	}
+
+	views, err := d.provider.client.GetViews(appID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching views", fmt.Sprintf("Unable to fetch views, got error: %s", err))
+		return
+	}
+
+	hooks, err := d.provider.client.GetHooks("app", appID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching hooks", fmt.Sprintf("Unable to fetch hooks, got error: %s", err))
+		return
+	}
+
+	template, diags := newAppTemplate(d.provider.client, app, views, hooks)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	encoded, err := json.MarshalIndent(template, "", "  ")
+	if err != nil {
+		resp.Diagnostics.AddError("Error encoding template", fmt.Sprintf("Unable to encode template, got error: %s", err))
+		return
+	}
+
+	data.TemplateJSON = types.String{Value: string(encoded)}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,534 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kayteh/podio-go"
+	"github.com/kayteh/terraform-provider-podio/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = appViewResourceType{}
+var _ tfsdk.Resource = appViewResource{}
+var _ tfsdk.ResourceWithValidateConfig = appViewResource{}
+
+type appViewResourceType struct{}
+
+func (t appViewResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "A saved view of the items within an app",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"app_id": {
+				MarkdownDescription: "ID of the app",
+				Type:                types.Int64Type,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"view_id": {
+				MarkdownDescription: "ID of the view",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"name": {
+				MarkdownDescription: "Name of the view",
+				Type:                types.StringType,
+				Required:            true,
+			},
+			"layout": {
+				MarkdownDescription: "Layout of the view. One of: `table`, `card`, `calendar`, `badge`, `stream`",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSliceValidator{"table", "card", "calendar", "badge", "stream"},
+				},
+			},
+			"private": {
+				MarkdownDescription: "True if the view is only visible to the user who created it. Defaults to `false`.",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"sort_by": {
+				MarkdownDescription: "What the items are sorted by, either a field ID or one of the item attributes like `created_on` or `title`",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"sort_desc": {
+				MarkdownDescription: "True if the items are sorted in descending order",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"grouping_field_id": {
+				MarkdownDescription: "ID of the field the items are grouped by",
+				Type:                types.Int64Type,
+				Optional:            true,
+			},
+			"visible_field_ids": {
+				MarkdownDescription: "IDs of the fields shown in the view, in order",
+				Type:                types.ListType{ElemType: types.Int64Type},
+				Optional:            true,
+			},
+		},
+
+		Blocks: map[string]tfsdk.Block{
+			"filter": {
+				MarkdownDescription: "A filter on the items shown in the view",
+				NestingMode:         tfsdk.BlockNestingModeList,
+				Attributes: map[string]tfsdk.Attribute{
+					"type": {
+						MarkdownDescription: "Type of the filter. One of: `category` (items with any of the option IDs in `values`), `app` (items referencing any of the item IDs in `values`), `created_by` (items created by any of the user IDs in `values`), `date` or `number` (items within the range of `from` and `to`)",
+						Type:                types.StringType,
+						Required:            true,
+						Validators: []tfsdk.AttributeValidator{
+							validators.StringInSliceValidator{"category", "app", "created_by", "date", "number"},
+						},
+					},
+					"field_id": {
+						MarkdownDescription: "ID of the field to filter on. Required for all types except `created_by`.",
+						Type:                types.Int64Type,
+						Optional:            true,
+					},
+					"values": {
+						MarkdownDescription: "IDs to filter on for `category`, `app` and `created_by` filters",
+						Type:                types.ListType{ElemType: types.Int64Type},
+						Optional:            true,
+					},
+					"from": {
+						MarkdownDescription: "Start of the range for `date` (`YYYY-MM-DD`) and `number` filters",
+						Type:                types.StringType,
+						Optional:            true,
+					},
+					"to": {
+						MarkdownDescription: "End of the range for `date` (`YYYY-MM-DD`) and `number` filters",
+						Type:                types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (t appViewResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return appViewResource{
+		provider: provider,
+	}, diags
+}
+
+type appViewResourceData struct {
+	AppID           types.Int64  `tfsdk:"app_id"`
+	ViewID          types.Int64  `tfsdk:"view_id"`
+	Name            types.String `tfsdk:"name"`
+	Layout          types.String `tfsdk:"layout"`
+	Private         types.Bool   `tfsdk:"private"`
+	SortBy          types.String `tfsdk:"sort_by"`
+	SortDesc        types.Bool   `tfsdk:"sort_desc"`
+	GroupingFieldID types.Int64  `tfsdk:"grouping_field_id"`
+	VisibleFieldIDs types.List   `tfsdk:"visible_field_ids"`
+
+	Filters []appViewFilterData `tfsdk:"filter"`
+}
+
+type appViewFilterData struct {
+	Type    types.String `tfsdk:"type"`
+	FieldID types.Int64  `tfsdk:"field_id"`
+	Values  types.List   `tfsdk:"values"`
+	From    types.String `tfsdk:"from"`
+	To      types.String `tfsdk:"to"`
+}
+
+// key returns the key Podio uses for the filter.
+func (f appViewFilterData) key() string {
+	if f.Type.Value == "created_by" {
+		return "created_by"
+	}
+
+	return strconv.FormatInt(f.FieldID.Value, 10)
+}
+
+// viewParams builds the Podio view from the Terraform data.
+func (data appViewResourceData) viewParams(ctx context.Context) (podio.CreateViewParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var fieldIDs []int64
+	diags.Append(data.VisibleFieldIDs.ElementsAs(ctx, &fieldIDs, true)...)
+
+	params := podio.CreateViewParams{
+		Name:            data.Name.Value,
+		Private:         data.Private.Value,
+		Layout:          data.Layout.Value,
+		SortBy:          data.SortBy.Value,
+		SortDesc:        data.SortDesc.Value,
+		GroupingFieldID: int(data.GroupingFieldID.Value),
+		Fields:          []int{},
+		Filters:         []podio.ViewFilter{},
+	}
+
+	for _, fieldID := range fieldIDs {
+		params.Fields = append(params.Fields, int(fieldID))
+	}
+
+	for _, filter := range data.Filters {
+		var values []int64
+		diags.Append(filter.Values.ElementsAs(ctx, &values, true)...)
+
+		f := podio.ViewFilter{
+			Key:    filter.key(),
+			Values: []int{},
+			From:   filter.From.Value,
+			To:     filter.To.Value,
+		}
+
+		for _, value := range values {
+			f.Values = append(f.Values, int(value))
+		}
+
+		params.Filters = append(params.Filters, f)
+	}
+
+	return params, diags
+}
+
+// setView copies the view returned by Podio into the Terraform data. The
+// field types of the app are used to tell the filter types apart.
+func (data *appViewResourceData) setView(view *podio.View, fieldTypes map[int]string) {
+	data.ViewID = types.Int64{Value: int64(view.ViewID)}
+	data.Name = types.String{Value: view.Name}
+	data.Layout = types.String{Value: view.Layout}
+	data.Private = types.Bool{Value: view.Private}
+	data.SortBy = types.String{Value: view.SortBy}
+	data.SortDesc = types.Bool{Value: view.SortDesc}
+
+	if !data.GroupingFieldID.Null || view.GroupingFieldID != 0 {
+		data.GroupingFieldID = types.Int64{Value: int64(view.GroupingFieldID)}
+	}
+
+	if !data.VisibleFieldIDs.Null || len(view.Fields) > 0 {
+		data.VisibleFieldIDs = types.List{ElemType: types.Int64Type, Elems: []attr.Value{}}
+		for _, fieldID := range view.Fields {
+			data.VisibleFieldIDs.Elems = append(data.VisibleFieldIDs.Elems, types.Int64{Value: int64(fieldID)})
+		}
+	}
+
+	prior := map[string]appViewFilterData{}
+	for _, filter := range data.Filters {
+		prior[filter.key()] = filter
+	}
+
+	filters := []appViewFilterData{}
+	for _, f := range view.Filters {
+		filter := appViewFilterData{
+			Type:    types.String{Value: "created_by"},
+			FieldID: types.Int64{Null: true},
+			Values:  types.List{ElemType: types.Int64Type, Null: true},
+			From:    types.String{Null: true},
+			To:      types.String{Null: true},
+		}
+
+		previous, known := prior[f.Key]
+
+		if f.Key != "created_by" {
+			fieldID, err := strconv.Atoi(f.Key)
+			if err != nil {
+				// Not a filter this resource knows how to manage.
+				continue
+			}
+
+			filter.FieldID = types.Int64{Value: int64(fieldID)}
+			filter.Type = types.String{Value: appViewFilterType(fieldTypes[fieldID])}
+
+			if known && fieldTypes[fieldID] == "" {
+				filter.Type = previous.Type
+			}
+		}
+
+		if len(f.Values) > 0 || (known && !previous.Values.Null) {
+			filter.Values = types.List{ElemType: types.Int64Type, Elems: []attr.Value{}}
+			for _, value := range f.Values {
+				filter.Values.Elems = append(filter.Values.Elems, types.Int64{Value: int64(value)})
+			}
+		}
+
+		if f.From != "" || (known && !previous.From.Null) {
+			filter.From = types.String{Value: f.From}
+		}
+
+		if f.To != "" || (known && !previous.To.Null) {
+			filter.To = types.String{Value: f.To}
+		}
+
+		filters = append(filters, filter)
+	}
+
+	data.Filters = filters
+}
+
+type appViewResource struct {
+	provider provider
+}
+
+func (r appViewResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var data appViewResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, filter := range data.Filters {
+		path := tftypes.NewAttributePath().WithAttributeName("filter").WithElementKeyInt(i)
+
+		if filter.Type.Unknown {
+			continue
+		}
+
+		switch filter.Type.Value {
+		case "created_by":
+			if !filter.FieldID.Null {
+				resp.Diagnostics.AddAttributeError(path, "Invalid filter", "`field_id` can't be used with `created_by` filters.")
+			}
+		default:
+			if filter.FieldID.Null {
+				resp.Diagnostics.AddAttributeError(path, "Invalid filter", fmt.Sprintf("`field_id` is required for `%s` filters.", filter.Type.Value))
+			}
+		}
+
+		switch filter.Type.Value {
+		case "category", "app", "created_by":
+			if filter.Values.Null || !filter.From.Null || !filter.To.Null {
+				resp.Diagnostics.AddAttributeError(path, "Invalid filter", fmt.Sprintf("`%s` filters require `values` and can't use `from` or `to`.", filter.Type.Value))
+			}
+		case "date", "number":
+			if !filter.Values.Null || (filter.From.Null && filter.To.Null) {
+				resp.Diagnostics.AddAttributeError(path, "Invalid filter", fmt.Sprintf("`%s` filters require `from` or `to` and can't use `values`.", filter.Type.Value))
+			}
+		}
+
+		if filter.Type.Value != "number" {
+			continue
+		}
+
+		for _, bound := range []types.String{filter.From, filter.To} {
+			if bound.Null || bound.Unknown {
+				continue
+			}
+
+			if _, err := strconv.ParseFloat(bound.Value, 64); err != nil {
+				resp.Diagnostics.AddAttributeError(path, "Invalid filter", fmt.Sprintf("%q is not a number.", bound.Value))
+			}
+		}
+	}
+}
+
+// fieldTypes returns the types of the fields within an app, keyed by ID.
+func (r appViewResource) fieldTypes(appID string) (map[int]string, error) {
+	app, err := r.provider.client.GetApplication(appID)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldTypes := map[int]string{}
+	for _, field := range app.Fields {
+		fieldTypes[field.FieldID] = field.Type
+	}
+
+	return fieldTypes, nil
+}
+
+func (r appViewResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data appViewResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.viewParams(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := strconv.Itoa(int(data.AppID.Value))
+
+	view, err := r.provider.client.CreateView(appID, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create view: %s", err))
+		return
+	}
+
+	fieldTypes, err := r.fieldTypes(appID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return
+	}
+
+	data.setView(view, fieldTypes)
+
+	tflog.Trace(ctx, "created a view in Podio")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r appViewResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data appViewResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := strconv.Itoa(int(data.AppID.Value))
+
+	view, err := r.provider.client.GetView(appID, strconv.Itoa(int(data.ViewID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get view: %s", err))
+		return
+	}
+
+	fieldTypes, err := r.fieldTypes(appID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return
+	}
+
+	data.setView(view, fieldTypes)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r appViewResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data appViewResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.viewParams(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	view, err := r.provider.client.UpdateView(strconv.Itoa(int(data.ViewID.Value)), params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update view: %s", err))
+		return
+	}
+
+	fieldTypes, err := r.fieldTypes(strconv.Itoa(int(data.AppID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return
+	}
+
+	data.setView(view, fieldTypes)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r appViewResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data appViewResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.provider.client.DeleteView(strconv.Itoa(int(data.ViewID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete view: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r appViewResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected an import ID in the format `app_id/view_id`")
+		return
+	}
+
+	appID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Unable to parse app_id: %s", err))
+		return
+	}
+
+	viewID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Unable to parse view_id: %s", err))
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("app_id"), appID)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("view_id"), viewID)
+	resp.Diagnostics.Append(diags...)
+}
+
+// appViewFilterType returns the filter type used for a field type.
+func appViewFilterType(fieldType string) string {
+	switch fieldType {
+	case "date":
+		return "date"
+	case "number", "money", "progress", "duration", "calculation":
+		return "number"
+	case "app":
+		return "app"
+	default:
+		return "category"
+	}
+}
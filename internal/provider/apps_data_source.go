@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = appsDataSourceType{}
+var _ tfsdk.DataSource = appsDataSource{}
+
+type appsDataSourceType struct{}
+
+func (t appsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "All apps within a space in Podio",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"space_id": {
+				Type:        types.Int64Type,
+				Description: "The numeric ID of the space to list apps from.",
+				Required:    true,
+			},
+			"include_inactive": {
+				Type:        types.BoolType,
+				Description: "Also list inactive apps. Defaults to `false`.",
+				Optional:    true,
+			},
+			"apps": {
+				Description: "Apps within the space",
+				Computed:    true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"app_id": {
+						Description: "ID of the app",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"name": {
+						Description: "Name of the app",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"item_name": {
+						Description: "Name of the item type of the app",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"type": {
+						Description: "Type of the app",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"icon": {
+						Description: "Icon of the app",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"external_id": {
+						Description: "External ID of the app",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"url_label": {
+						Description: "The URL label/slug of the app within its space",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"url": {
+						Description: "URL of the app",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"status": {
+						Description: "Status of the app, either `active` or `inactive`",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t appsDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return appsDataSource{
+		provider: provider,
+	}, diags
+}
+
+type appsDataSourceData struct {
+	SpaceID         types.Int64           `tfsdk:"space_id"`
+	IncludeInactive types.Bool            `tfsdk:"include_inactive"`
+	Apps            []appsDataSourceEntry `tfsdk:"apps"`
+}
+
+type appsDataSourceEntry struct {
+	AppID      types.Int64  `tfsdk:"app_id"`
+	Name       types.String `tfsdk:"name"`
+	ItemName   types.String `tfsdk:"item_name"`
+	Type       types.String `tfsdk:"type"`
+	Icon       types.String `tfsdk:"icon"`
+	ExternalID types.String `tfsdk:"external_id"`
+	URLLabel   types.String `tfsdk:"url_label"`
+	URL        types.String `tfsdk:"url"`
+	Status     types.String `tfsdk:"status"`
+}
+
+type appsDataSource struct {
+	provider provider
+}
+
+func (d appsDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data appsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apps, err := d.provider.client.GetSpaceApplications(fmt.Sprintf("%d", data.SpaceID.Value), data.IncludeInactive.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching apps", fmt.Sprintf("Unable to fetch apps, got error: %s", err))
+		return
+	}
+
+	data.Apps = []appsDataSourceEntry{}
+	for _, app := range apps {
+		data.Apps = append(data.Apps, appsDataSourceEntry{
+			AppID:      types.Int64{Value: int64(app.AppID)},
+			Name:       types.String{Value: app.Config.Name},
+			ItemName:   types.String{Value: app.Config.ItemName},
+			Type:       types.String{Value: app.Config.Type},
+			Icon:       types.String{Value: app.Config.Icon},
+			ExternalID: types.String{Value: app.Config.ExternalID},
+			URLLabel:   types.String{Value: app.URLLabel},
+			URL:        types.String{Value: app.Link},
+			Status:     types.String{Value: app.Status},
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
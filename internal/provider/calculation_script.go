@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kayteh/podio-go"
+	"github.com/robertkrimen/otto/parser"
+)
+
+// calculationFieldToken matches references to other fields in the script of
+// a calculation field, e.g. `@[Price](field_1234)`.
+var calculationFieldToken = regexp.MustCompile(`@\[([^\]]*)\]\(field_(\d+)\)`)
+
+// calculationTemplateToken matches references to other fields in the script
+// of a calculation field within an app template, which refer to the fields by
+// external_id, e.g. `@[Price](external_id:price)`.
+var calculationTemplateToken = regexp.MustCompile(`@\[([^\]]*)\]\(external_id:([^)]+)\)`)
+
+// parseCalculationScript checks that a calculation script is valid JavaScript
+// once its field tokens are replaced, and returns the referenced field IDs.
+func parseCalculationScript(script string) ([]int, error) {
+	fieldIDs := []int{}
+
+	for _, match := range calculationFieldToken.FindAllStringSubmatch(script, -1) {
+		fieldID, err := strconv.Atoi(match[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid field reference %s: %w", match[0], err)
+		}
+
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+
+	source := calculationFieldToken.ReplaceAllString(script, "field_$2")
+
+	_, err := parser.ParseFile(nil, "", source, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return fieldIDs, nil
+}
+
+// unknownCalculationFields returns the referenced field IDs that don't belong
+// to an active field of the app.
+func unknownCalculationFields(fieldIDs []int, fields []podio.AppField) []int {
+	existing := map[int]bool{}
+	for _, field := range fields {
+		if field.Status != "deleted" {
+			existing[field.FieldID] = true
+		}
+	}
+
+	unknown := []int{}
+	for _, fieldID := range fieldIDs {
+		if !existing[fieldID] {
+			unknown = append(unknown, fieldID)
+		}
+	}
+
+	return unknown
+}
+
+// calculationScriptToTemplate replaces the field IDs referenced by a
+// calculation script with the external_ids of the fields, given by field ID.
+func calculationScriptToTemplate(script string, externalIDs map[int]string) (string, error) {
+	var err error
+
+	script = calculationFieldToken.ReplaceAllStringFunc(script, func(token string) string {
+		match := calculationFieldToken.FindStringSubmatch(token)
+
+		fieldID, _ := strconv.Atoi(match[2])
+		externalID, ok := externalIDs[fieldID]
+		if !ok {
+			err = fmt.Errorf("the script references field %d, which doesn't exist in the app", fieldID)
+			return token
+		}
+
+		return fmt.Sprintf("@[%s](external_id:%s)", match[1], externalID)
+	})
+
+	return script, err
+}
+
+// calculationScriptFromTemplate replaces the external_ids referenced by the
+// calculation script of a template with the IDs of the fields, given by
+// external_id.
+func calculationScriptFromTemplate(script string, fieldIDs map[string]int) (string, error) {
+	missing := []string{}
+
+	script = calculationTemplateToken.ReplaceAllStringFunc(script, func(token string) string {
+		match := calculationTemplateToken.FindStringSubmatch(token)
+
+		fieldID, ok := fieldIDs[match[2]]
+		if !ok {
+			missing = append(missing, match[2])
+			return token
+		}
+
+		return fmt.Sprintf("@[%s](field_%d)", match[1], fieldID)
+	})
+
+	if len(missing) > 0 {
+		return script, fmt.Errorf("the script references fields %s, which don't exist in the app", strings.Join(missing, ", "))
+	}
+
+	return script, nil
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kayteh/podio-go"
+)
+
+func TestParseCalculationScript(t *testing.T) {
+	cases := []struct {
+		name     string
+		script   string
+		fieldIDs []int
+		err      bool
+	}{
+		{
+			name:     "no references",
+			script:   "1 + 2",
+			fieldIDs: []int{},
+		},
+		{
+			name:     "single reference",
+			script:   "@[Price](field_1234) * 2",
+			fieldIDs: []int{1234},
+		},
+		{
+			name:     "multiple references in order",
+			script:   "@[Price](field_1234) * @[Quantity](field_5678) + @[Price](field_1234)",
+			fieldIDs: []int{1234, 5678, 1234},
+		},
+		{
+			name:     "label with spaces and punctuation",
+			script:   "var total = @[Unit price (EUR)](field_42);\ntotal * 1.25",
+			fieldIDs: []int{42},
+		},
+		{
+			name:     "reference without field prefix is plain text",
+			script:   "\"@[Price](1234)\"",
+			fieldIDs: []int{},
+		},
+		{
+			name:   "invalid syntax",
+			script: "@[Price](field_1234) *",
+			err:    true,
+		},
+		{
+			name:   "unbalanced parentheses",
+			script: "(@[Price](field_1234) + 1",
+			err:    true,
+		},
+		{
+			name:   "malformed reference",
+			script: "@[Price](field_) + 1",
+			err:    true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			fieldIDs, err := parseCalculationScript(c.script)
+
+			if c.err {
+				if err == nil {
+					t.Fatalf("expected an error, got field IDs %v", fieldIDs)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(fieldIDs, c.fieldIDs) {
+				t.Errorf("expected field IDs %v, got %v", c.fieldIDs, fieldIDs)
+			}
+		})
+	}
+}
+
+func TestUnknownCalculationFields(t *testing.T) {
+	fields := []podio.AppField{
+		{FieldID: 1, Status: "active"},
+		{FieldID: 2, Status: "active"},
+		{FieldID: 3, Status: "deleted"},
+	}
+
+	cases := []struct {
+		name     string
+		fieldIDs []int
+		unknown  []int
+	}{
+		{
+			name:     "all known",
+			fieldIDs: []int{1, 2},
+			unknown:  []int{},
+		},
+		{
+			name:     "missing field",
+			fieldIDs: []int{1, 4},
+			unknown:  []int{4},
+		},
+		{
+			name:     "deleted field",
+			fieldIDs: []int{3, 2},
+			unknown:  []int{3},
+		},
+		{
+			name:     "no references",
+			fieldIDs: []int{},
+			unknown:  []int{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			unknown := unknownCalculationFields(c.fieldIDs, fields)
+
+			if !reflect.DeepEqual(unknown, c.unknown) {
+				t.Errorf("expected unknown fields %v, got %v", c.unknown, unknown)
+			}
+		})
+	}
+}
+
+func TestCalculationScriptTemplate(t *testing.T) {
+	externalIDs := map[int]string{1234: "price", 5678: "quantity"}
+
+	script := "@[Price](field_1234) * @[Quantity](field_5678)"
+	template := "@[Price](external_id:price) * @[Quantity](external_id:quantity)"
+
+	exported, err := calculationScriptToTemplate(script, externalIDs)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if exported != template {
+		t.Errorf("expected %q, got %q", template, exported)
+	}
+
+	if _, err := calculationScriptToTemplate("@[Gone](field_1) + 1", externalIDs); err == nil {
+		t.Error("expected an error for a field that doesn't exist")
+	}
+
+	applied, err := calculationScriptFromTemplate(template, map[string]int{"price": 11, "quantity": 12})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := "@[Price](field_11) * @[Quantity](field_12)"; applied != expected {
+		t.Errorf("expected %q, got %q", expected, applied)
+	}
+
+	if _, err := calculationScriptFromTemplate(template, map[string]int{"price": 11}); err == nil {
+		t.Error("expected an error for a field that doesn't exist")
+	}
+}
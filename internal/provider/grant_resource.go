@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kayteh/podio-go"
+	"github.com/kayteh/terraform-provider-podio/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = grantResourceType{}
+var _ tfsdk.Resource = grantResource{}
+var _ tfsdk.ResourceWithValidateConfig = grantResource{}
+
+type grantResourceType struct{}
+
+func (t grantResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "A grant that shares an app or item with a user, without making them a member of the space",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"ref_type": {
+				MarkdownDescription: "Type of the object that is shared. One of: `app`, `item`",
+				Type:                types.StringType,
+				Required:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSliceValidator{"app", "item"},
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"ref_id": {
+				MarkdownDescription: "ID of the object that is shared",
+				Type:                types.Int64Type,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"user_id": {
+				MarkdownDescription: "ID of the user the object is shared with. Mutually exclusive with `email`.",
+				Type:                types.Int64Type,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"email": {
+				MarkdownDescription: "Email address of the user the object is shared with. Users without a Podio account are invited. Mutually exclusive with `user_id`.",
+				Type:                types.StringType,
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"action": {
+				MarkdownDescription: "Access level of the grant. One of: `view`, `comment`, `rate`, `edit`",
+				Type:                types.StringType,
+				Required:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSliceValidator{"view", "comment", "rate", "edit"},
+				},
+			},
+			"message": {
+				MarkdownDescription: "Message sent to the user when the grant is created",
+				Type:                types.StringType,
+				Optional:            true,
+			},
+		},
+	}, nil
+}
+
+func (t grantResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return grantResource{
+		provider: provider,
+	}, diags
+}
+
+type grantResourceData struct {
+	RefType types.String `tfsdk:"ref_type"`
+	RefID   types.Int64  `tfsdk:"ref_id"`
+	UserID  types.Int64  `tfsdk:"user_id"`
+	Email   types.String `tfsdk:"email"`
+	Action  types.String `tfsdk:"action"`
+	Message types.String `tfsdk:"message"`
+}
+
+type grantResource struct {
+	provider provider
+}
+
+func (r grantResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var data grantResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.UserID.Null && !data.Email.Null {
+		resp.Diagnostics.AddError("Ambiguous grant user", "Only set one of `user_id` or `email`, not both.")
+		return
+	}
+
+	if data.UserID.Null && data.Email.Null {
+		resp.Diagnostics.AddError("No grant user specified", "Either `user_id` or `email` must be specified")
+	}
+}
+
+// grant creates or updates the grant in Podio. Podio replaces an existing
+// grant of the user on the same object.
+func (r grantResource) grant(data *grantResourceData) error {
+	grant, err := r.provider.client.CreateGrant(
+		data.RefType.Value,
+		strconv.Itoa(int(data.RefID.Value)),
+		podio.CreateGrantParams{
+			UserID:  int(data.UserID.Value),
+			Email:   data.Email.Value,
+			Action:  data.Action.Value,
+			Message: data.Message.Value,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	data.UserID = types.Int64{Value: int64(grant.UserID)}
+	data.Action = types.String{Value: grant.Action}
+
+	return nil
+}
+
+// getGrant returns the grant of the user on the object, or nil if there is
+// none.
+func (r grantResource) getGrant(data grantResourceData) (*podio.Grant, error) {
+	grants, err := r.provider.client.GetGrants(data.RefType.Value, strconv.Itoa(int(data.RefID.Value)))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, grant := range grants {
+		if int64(grant.UserID) == data.UserID.Value {
+			return &grant, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r grantResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data grantResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.grant(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create grant: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a grant in Podio")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r grantResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data grantResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	grant, err := r.getGrant(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get grant: %s", err))
+		return
+	}
+
+	if grant == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Action = types.String{Value: grant.Action}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r grantResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data grantResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.grant(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update grant: %s", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r grantResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data grantResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.provider.client.DeleteGrant(
+		data.RefType.Value,
+		strconv.Itoa(int(data.RefID.Value)),
+		strconv.Itoa(int(data.UserID.Value)),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete grant: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r grantResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected an import ID in the format `ref_type/ref_id/user_id`")
+		return
+	}
+
+	refID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Unable to parse ref_id: %s", err))
+		return
+	}
+
+	userID, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Unable to parse user_id: %s", err))
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("ref_type"), parts[0])
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("ref_id"), refID)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("user_id"), userID)
+	resp.Diagnostics.Append(diags...)
+}
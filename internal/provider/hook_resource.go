@@ -0,0 +1,443 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kayteh/podio-go"
+	"github.com/kayteh/terraform-provider-podio/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = hookResourceType{}
+var _ tfsdk.Resource = hookResource{}
+var _ tfsdk.ResourceWithModifyPlan = hookResource{}
+
+// hookVerificationInterval is how often the status of a hook is checked while
+// waiting for it to become active.
+const hookVerificationInterval = 5 * time.Second
+
+// hookTypes are the events a hook can be triggered by.
+var hookTypes = []string{
+	"item.create", "item.update", "item.delete",
+	"comment.create", "comment.delete",
+	"file.change",
+	"tag.add", "tag.delete",
+	"form.create", "form.update", "form.delete",
+	"app.create", "app.update", "app.delete",
+	"app_field.create", "app_field.update", "app_field.delete",
+	"task.create", "task.update", "task.delete",
+	"member.add", "member.remove",
+	"status.update",
+}
+
+type hookResourceType struct{}
+
+func (t hookResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "A webhook on an app, space or app field. Podio hooks can't be changed, so any change creates a new hook.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"hook_id": {
+				MarkdownDescription: "ID of the hook",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"ref_type": {
+				MarkdownDescription: "Type of the object the hook is on. One of: `app`, `space`, `app_field`",
+				Type:                types.StringType,
+				Required:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSliceValidator{"app", "space", "app_field"},
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"ref_id": {
+				MarkdownDescription: "ID of the object the hook is on",
+				Type:                types.Int64Type,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"url": {
+				MarkdownDescription: "URL the events are posted to",
+				Type:                types.StringType,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"type": {
+				MarkdownDescription: "Event that triggers the hook, e.g. `item.create`",
+				Type:                types.StringType,
+				Required:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSliceValidator(hookTypes),
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"status": {
+				MarkdownDescription: "Status of the hook, either `inactive` until it has been verified, or `active`",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"verification_code": {
+				MarkdownDescription: "Verification code received by the hook URL. When set, the code is submitted to Podio and the apply waits until the hook is `active`. A code can only be used once: if the hook drops back to `inactive` later, the apply requests a new code, which has to be set here before the next apply.",
+				Type:                types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"wait_for_active": {
+				MarkdownDescription: "If true, verification is requested and the apply waits until the hook is `active`. Use this when the receiver verifies the hook itself.",
+				Type:                types.BoolType,
+				Optional:            true,
+			},
+			"verification_timeout": {
+				MarkdownDescription: "How long to wait for the hook to become `active`, e.g. `30s` or `5m`. Defaults to `5m`.",
+				Type:                types.StringType,
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringMatchesRegexpValidator{
+						Regexp: regexp.MustCompile(`^(\d+(\.\d+)?(ms|s|m|h))+$`),
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func (t hookResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return hookResource{
+		provider: provider,
+	}, diags
+}
+
+type hookResourceData struct {
+	HookID  types.Int64  `tfsdk:"hook_id"`
+	RefType types.String `tfsdk:"ref_type"`
+	RefID   types.Int64  `tfsdk:"ref_id"`
+	URL     types.String `tfsdk:"url"`
+	Type    types.String `tfsdk:"type"`
+	Status  types.String `tfsdk:"status"`
+
+	VerificationCode    types.String `tfsdk:"verification_code"`
+	WaitForActive       types.Bool   `tfsdk:"wait_for_active"`
+	VerificationTimeout types.String `tfsdk:"verification_timeout"`
+}
+
+// verifies reports whether the hook should be verified during apply.
+func (data hookResourceData) verifies() bool {
+	return (!data.VerificationCode.Null && data.VerificationCode.Value != "") || data.WaitForActive.Value
+}
+
+func (data *hookResourceData) setHook(hook *podio.Hook) {
+	data.HookID = types.Int64{Value: int64(hook.HookID)}
+	data.URL = types.String{Value: hook.URL}
+	data.Type = types.String{Value: hook.Type}
+	data.Status = types.String{Value: hook.Status}
+}
+
+type hookResource struct {
+	provider provider
+}
+
+// getHook finds a hook among the hooks of the object it is on. Podio has no
+// endpoint to get a single hook. A nil hook is returned if it doesn't exist.
+func (r hookResource) getHook(data hookResourceData) (*podio.Hook, error) {
+	hooks, err := r.provider.client.GetHooks(data.RefType.Value, strconv.Itoa(int(data.RefID.Value)))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hook := range hooks {
+		if int64(hook.HookID) == data.HookID.Value {
+			return &hook, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// verify requests verification of an inactive hook, submits the
+// verification code if there is one, and waits until the hook is active.
+// usedCode is the code submitted by an earlier apply. Podio doesn't accept a
+// code twice, so a new one is requested instead and has to be fed back in.
+func (r hookResource) verify(ctx context.Context, data *hookResourceData, usedCode string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !data.verifies() || data.Status.Value == "active" {
+		return diags
+	}
+
+	timeout := 5 * time.Minute
+	if !data.VerificationTimeout.Null {
+		parsed, err := time.ParseDuration(data.VerificationTimeout.Value)
+		if err != nil {
+			diags.AddError("Invalid verification timeout", fmt.Sprintf("Unable to parse verification_timeout: %s", err))
+			return diags
+		}
+
+		timeout = parsed
+	}
+
+	hookID := strconv.Itoa(int(data.HookID.Value))
+
+	if data.VerificationCode.Null || data.VerificationCode.Value == "" || data.VerificationCode.Value == usedCode {
+		err := r.provider.client.RequestHookVerification(hookID)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to request hook verification: %s", err))
+			return diags
+		}
+
+		if data.VerificationCode.Value == usedCode && !data.WaitForActive.Value {
+			diags.AddWarning(
+				"Hook verification requested",
+				fmt.Sprintf("The verification code of hook %d was already used. Podio sent a new code to %s, set verification_code to it and apply again.", data.HookID.Value, data.URL.Value),
+			)
+			return diags
+		}
+	} else {
+		err := r.provider.client.ValidateHookVerification(hookID, data.VerificationCode.Value)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to verify hook: %s", err))
+			return diags
+		}
+	}
+
+	tflog.Trace(ctx, "verifying a hook in Podio")
+
+	deadline := time.After(timeout)
+
+	for {
+		hook, err := r.getHook(*data)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to get hook: %s", err))
+			return diags
+		}
+
+		if hook == nil {
+			diags.AddError("Client Error", fmt.Sprintf("Hook %d disappeared while it was being verified", data.HookID.Value))
+			return diags
+		}
+
+		data.setHook(hook)
+
+		if hook.Status == "active" {
+			return diags
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("Hook verification cancelled", ctx.Err().Error())
+			return diags
+		case <-deadline:
+			diags.AddError(
+				"Hook verification timed out",
+				fmt.Sprintf("Hook %d is still %s after %s. Check that %s received the verification code.", data.HookID.Value, hook.Status, timeout, data.URL.Value),
+			)
+			return diags
+		case <-time.After(hookVerificationInterval):
+		}
+	}
+}
+
+func (r hookResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, data hookResourceData
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	diags = req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Plan a re-verification when the hook dropped back to inactive.
+	if data.verifies() && state.Status.Value != "active" {
+		diags = resp.Plan.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("status"), types.String{Unknown: true})
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+func (r hookResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data hookResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hook, err := r.provider.client.CreateHook(
+		data.RefType.Value,
+		strconv.Itoa(int(data.RefID.Value)),
+		podio.CreateHookParams{
+			URL:  data.URL.Value,
+			Type: data.Type.Value,
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create hook: %s", err))
+		return
+	}
+
+	data.HookID = types.Int64{Value: int64(hook.HookID)}
+
+	hook, err = r.getHook(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get hook: %s", err))
+		return
+	}
+
+	if hook == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Hook %d disappeared right after it was created", data.HookID.Value))
+		return
+	}
+
+	data.setHook(hook)
+
+	tflog.Trace(ctx, "created a hook in Podio")
+
+	diags = r.verify(ctx, &data, "")
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r hookResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data hookResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hook, err := r.getHook(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get hook: %s", err))
+		return
+	}
+
+	if hook == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.setHook(hook)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r hookResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	// Only the verification attributes can change in place, everything else
+	// requires replacement.
+	var data, state hookResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hook, err := r.getHook(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get hook: %s", err))
+		return
+	}
+
+	if hook == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Hook %d no longer exists", data.HookID.Value))
+		return
+	}
+
+	data.setHook(hook)
+
+	diags = r.verify(ctx, &data, state.VerificationCode.Value)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r hookResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data hookResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.provider.client.DeleteHook(strconv.Itoa(int(data.HookID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete hook: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r hookResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected an import ID in the format `ref_type/ref_id/hook_id`")
+		return
+	}
+
+	refID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Unable to parse ref_id: %s", err))
+		return
+	}
+
+	hookID, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Unable to parse hook_id: %s", err))
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("ref_type"), parts[0])
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("ref_id"), refID)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("hook_id"), hookID)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = iconSearchDataSourceType{}
+var _ tfsdk.DataSource = iconSearchDataSource{}
+
+type iconSearchDataSourceType struct{}
+
+func (t iconSearchDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Searches the Podio app icons by keyword, for use as the `icon` of a `podio_app`",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"query": {
+				Type:        types.StringType,
+				Description: "Keyword to search the icons for, e.g. `calendar`",
+				Required:    true,
+			},
+			"limit": {
+				Type:        types.Int64Type,
+				Description: "Maximum number of icons to return. Defaults to `10`.",
+				Optional:    true,
+			},
+			"icons": {
+				Description: "Matching icons in the `12.png` format used by the `icon` attribute of `podio_app`, best match first",
+				Type:        types.ListType{ElemType: types.StringType},
+				Computed:    true,
+			},
+			"icon_ids": {
+				Description: "IDs of the matching icons, in the same order as `icons`",
+				Type:        types.ListType{ElemType: types.Int64Type},
+				Computed:    true,
+			},
+			"urls": {
+				Description: "URLs of the images of the matching icons, in the same order as `icons`",
+				Type:        types.ListType{ElemType: types.StringType},
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+func (t iconSearchDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return iconSearchDataSource{
+		provider: provider,
+	}, diags
+}
+
+type iconSearchDataSourceData struct {
+	Query   types.String `tfsdk:"query"`
+	Limit   types.Int64  `tfsdk:"limit"`
+	Icons   types.List   `tfsdk:"icons"`
+	IconIDs types.List   `tfsdk:"icon_ids"`
+	URLs    types.List   `tfsdk:"urls"`
+}
+
+type iconSearchDataSource struct {
+	provider provider
+}
+
+func (d iconSearchDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data iconSearchDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := 10
+	if !data.Limit.Null {
+		limit = int(data.Limit.Value)
+	}
+
+	icons, err := d.provider.client.SearchAppIcons(data.Query.Value, limit)
+	if err != nil {
+		resp.Diagnostics.AddError("Error searching icons", fmt.Sprintf("Unable to search icons, got error: %s", err))
+		return
+	}
+
+	data.Icons = types.List{ElemType: types.StringType, Elems: []attr.Value{}}
+	data.IconIDs = types.List{ElemType: types.Int64Type, Elems: []attr.Value{}}
+	data.URLs = types.List{ElemType: types.StringType, Elems: []attr.Value{}}
+
+	for _, icon := range icons {
+		data.Icons.Elems = append(data.Icons.Elems, types.String{Value: fmt.Sprintf("%d.png", icon.IconID)})
+		data.IconIDs.Elems = append(data.IconIDs.Elems, types.Int64{Value: int64(icon.IconID)})
+		data.URLs.Elems = append(data.URLs.Elems, types.String{Value: icon.URL})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
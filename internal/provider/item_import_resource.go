@@ -0,0 +1,556 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kayteh/podio-go"
+	"github.com/xuri/excelize/v2"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = itemImportResourceType{}
+var _ tfsdk.Resource = itemImportResource{}
+var _ tfsdk.ResourceWithValidateConfig = itemImportResource{}
+var _ tfsdk.ResourceWithModifyPlan = itemImportResource{}
+
+type itemImportResourceType struct{}
+
+func (t itemImportResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Imports the rows of a local CSV or XLSX file as items of an app. Rows are matched with existing items by `match_key`, so existing items are updated instead of duplicated. The file is only imported again when its content changes. Destroying the resource leaves the items in Podio.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"app_id": {
+				MarkdownDescription: "ID of the app",
+				Type:                types.Int64Type,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"path": {
+				MarkdownDescription: "Path to the file. Files ending in `.xlsx` are read as spreadsheets, anything else as CSV. The first row must hold the column names.",
+				Type:                types.StringType,
+				Required:            true,
+			},
+			"sheet": {
+				MarkdownDescription: "Name of the sheet to read from an XLSX file. Defaults to the first sheet.",
+				Type:                types.StringType,
+				Optional:            true,
+			},
+			"columns": {
+				MarkdownDescription: "External IDs of the fields the columns are imported into, keyed by column name. Category options are matched by text, multiple options and item IDs of app reference fields are separated by `;`. Empty cells are left alone.",
+				Type:                types.MapType{ElemType: types.StringType},
+				Required:            true,
+			},
+			"external_id_column": {
+				MarkdownDescription: "Name of the column holding the external IDs of the items",
+				Type:                types.StringType,
+				Optional:            true,
+			},
+			"match_key": {
+				MarkdownDescription: "How rows are matched with existing items: `external_id` (default) to match on `external_id_column`, or the external ID of a unique text or number field.",
+				Type:                types.StringType,
+				Optional:            true,
+			},
+			"content_hash": {
+				MarkdownDescription: "SHA-256 hash of the imported file",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"item_ids": {
+				MarkdownDescription: "IDs of the imported items, keyed by their match key",
+				Type:                types.MapType{ElemType: types.Int64Type},
+				Computed:            true,
+			},
+		},
+	}, nil
+}
+
+func (t itemImportResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return itemImportResource{
+		provider: provider,
+	}, diags
+}
+
+type itemImportResourceData struct {
+	AppID            types.Int64  `tfsdk:"app_id"`
+	Path             types.String `tfsdk:"path"`
+	Sheet            types.String `tfsdk:"sheet"`
+	Columns          types.Map    `tfsdk:"columns"`
+	ExternalIDColumn types.String `tfsdk:"external_id_column"`
+	MatchKey         types.String `tfsdk:"match_key"`
+	ContentHash      types.String `tfsdk:"content_hash"`
+	ItemIDs          types.Map    `tfsdk:"item_ids"`
+}
+
+// matchKey returns the match key, defaulting to `external_id`.
+func (data itemImportResourceData) matchKey() string {
+	if data.MatchKey.Null || data.MatchKey.Value == "" {
+		return "external_id"
+	}
+
+	return data.MatchKey.Value
+}
+
+// hashFile returns the hex encoded SHA-256 hash of a file.
+func hashFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// readItemImportRows reads the rows of a CSV or XLSX file as maps keyed by
+// the column names in the first row.
+func readItemImportRows(path string, sheet string) ([]map[string]string, error) {
+	var records [][]string
+
+	if strings.EqualFold(filepath.Ext(path), ".xlsx") {
+		f, err := excelize.OpenFile(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		if sheet == "" {
+			sheet = f.GetSheetName(0)
+		}
+
+		records, err = f.GetRows(sheet)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		reader := csv.NewReader(f)
+		reader.FieldsPerRecord = -1
+
+		records, err = reader.ReadAll()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%s has no header row", path)
+	}
+
+	header := records[0]
+	rows := []map[string]string{}
+
+	for _, record := range records[1:] {
+		row := map[string]string{}
+		for i, column := range header {
+			if i < len(record) {
+				row[strings.TrimSpace(column)] = strings.TrimSpace(record[i])
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// itemImportValue converts a cell into the value of a field of the given
+// type.
+func itemImportValue(fieldType string, cell string) (itemFieldValueData, error) {
+	value := newItemFieldValueData("", nil)
+
+	switch fieldType {
+	case "text":
+		value.Text = types.String{Value: cell}
+	case "number":
+		number, err := strconv.ParseFloat(cell, 64)
+		if err != nil {
+			return value, fmt.Errorf("%q is not a number", cell)
+		}
+
+		value.Number = types.Float64{Value: number}
+	case "category":
+		value.Options = types.List{ElemType: types.StringType, Elems: []attr.Value{}}
+		for _, text := range strings.Split(cell, ";") {
+			value.Options.Elems = append(value.Options.Elems, types.String{Value: strings.TrimSpace(text)})
+		}
+	case "date":
+		value.Start = types.String{Value: cell}
+	case "app":
+		value.ItemIDs = types.List{ElemType: types.Int64Type, Elems: []attr.Value{}}
+		for _, id := range strings.Split(cell, ";") {
+			itemID, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
+			if err != nil {
+				return value, fmt.Errorf("%q is not an item ID", id)
+			}
+
+			value.ItemIDs.Elems = append(value.ItemIDs.Elems, types.Int64{Value: itemID})
+		}
+	default:
+		return value, fmt.Errorf("%s fields can't be imported", fieldType)
+	}
+
+	return value, nil
+}
+
+// itemMatchValue returns the value of a text or number field used to match
+// rows with items.
+func itemMatchValue(value itemFieldValueData) string {
+	if !value.Number.Null {
+		return strconv.FormatFloat(value.Number.Value, 'f', -1, 64)
+	}
+
+	return value.Text.Value
+}
+
+type itemImportResource struct {
+	provider provider
+}
+
+func (r itemImportResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var data itemImportResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || data.MatchKey.Unknown {
+		return
+	}
+
+	if data.matchKey() == "external_id" && data.ExternalIDColumn.Null {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("external_id_column"),
+			"Missing external_id_column",
+			"`external_id_column` must be set when matching items by `external_id`.",
+		)
+	}
+}
+
+// ModifyPlan hashes the file, so that a changed file shows up as a change of
+// content_hash.
+func (r itemImportResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data itemImportResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || data.Path.Unknown {
+		return
+	}
+
+	hash, err := hashFile(data.Path.Value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("path"),
+			"Unable to read file",
+			fmt.Sprintf("Unable to read %s: %s", data.Path.Value, err),
+		)
+		return
+	}
+
+	var prior types.String
+
+	if !req.State.Raw.IsNull() {
+		diags = req.State.GetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("content_hash"), &prior)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data.planContentHash(hash, prior)
+
+	diags = resp.Plan.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// planContentHash sets the hash of the file in the plan. The framework only
+// marks computed attributes unknown when the configuration changed, so when
+// only the content of the file changed the item IDs are marked unknown here,
+// as the import will change them.
+func (data *itemImportResourceData) planContentHash(hash string, prior types.String) {
+	data.ContentHash = types.String{Value: hash}
+
+	if prior.Null || prior.Unknown || prior.Value != hash {
+		data.ItemIDs = types.Map{ElemType: types.Int64Type, Unknown: true}
+	}
+}
+
+// importItems upserts the rows of the file as items and records their IDs.
+func (r itemImportResource) importItems(ctx context.Context, data *itemImportResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	hash, err := hashFile(data.Path.Value)
+	if err != nil {
+		diags.AddError("Unable to read file", fmt.Sprintf("Unable to read %s: %s", data.Path.Value, err))
+		return diags
+	}
+
+	rows, err := readItemImportRows(data.Path.Value, data.Sheet.Value)
+	if err != nil {
+		diags.AddError("Unable to read file", fmt.Sprintf("Unable to read %s: %s", data.Path.Value, err))
+		return diags
+	}
+
+	columns := map[string]string{}
+	diags.Append(data.Columns.ElementsAs(ctx, &columns, false)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	appID := strconv.Itoa(int(data.AppID.Value))
+
+	app, err := r.provider.client.GetApplication(appID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return diags
+	}
+
+	fieldTypes := map[string]string{}
+	for _, field := range sortedAppFields(app.Fields) {
+		fieldTypes[field.ExternalID] = field.Type
+	}
+
+	matchKey := data.matchKey()
+	if matchKey != "external_id" && fieldTypes[matchKey] != "text" && fieldTypes[matchKey] != "number" {
+		diags.AddError("Invalid match_key", fmt.Sprintf("Field %q doesn't exist in app %d or is not a text or number field", matchKey, data.AppID.Value))
+		return diags
+	}
+
+	items, err := filterAllItems(r.provider.client, appID, podio.FilterItemsParams{})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list items: %s", err))
+		return diags
+	}
+
+	existing := map[string]int{}
+	for _, item := range items {
+		if matchKey == "external_id" {
+			existing[item.ExternalID] = item.ItemID
+			continue
+		}
+
+		for _, field := range item.Fields {
+			if field.ExternalID == matchKey {
+				existing[itemMatchValue(newItemFieldValueData(field.Type, field.Values))] = item.ItemID
+			}
+		}
+	}
+
+	// Every row is validated before the first item is written, so a file
+	// with errors doesn't leave a partial import behind.
+	type importRow struct {
+		line   int
+		key    string
+		params podio.CreateItemParams
+	}
+
+	parsed := []importRow{}
+	keys := map[string]bool{}
+
+	for i, row := range rows {
+		// Rows are numbered as in the file, after the header row.
+		line := i + 2
+
+		item := itemResourceData{
+			AppID:  data.AppID,
+			Fields: map[string]itemFieldValueData{},
+		}
+
+		if !data.ExternalIDColumn.Null {
+			item.ExternalID = types.String{Value: row[data.ExternalIDColumn.Value]}
+		}
+
+		valid := true
+		for column, externalID := range columns {
+			cell := row[column]
+			if cell == "" {
+				continue
+			}
+
+			value, err := itemImportValue(fieldTypes[externalID], cell)
+			if err != nil {
+				diags.AddError("Invalid cell", fmt.Sprintf("Row %d, column %q: %s", line, column, err))
+				valid = false
+				continue
+			}
+
+			item.Fields[externalID] = value
+		}
+
+		if !valid {
+			continue
+		}
+
+		key := item.ExternalID.Value
+		if matchKey != "external_id" {
+			key = itemMatchValue(item.Fields[matchKey])
+		}
+
+		if key == "" {
+			diags.AddError("Missing match key", fmt.Sprintf("Row %d has no value to match items by", line))
+			continue
+		}
+
+		if keys[key] {
+			diags.AddError("Duplicate match key", fmt.Sprintf("Row %d has the same match key %q as an earlier row", line, key))
+			continue
+		}
+
+		keys[key] = true
+
+		params, d := item.itemParams(ctx, app.Fields)
+		diags.Append(d...)
+
+		if d.HasError() {
+			continue
+		}
+
+		parsed = append(parsed, importRow{line: line, key: key, params: params})
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	itemIDs := types.Map{ElemType: types.Int64Type, Elems: map[string]attr.Value{}}
+
+	for i, row := range parsed {
+		itemID, ok := existing[row.key]
+		if ok {
+			_, err = r.provider.client.UpdateItem(strconv.Itoa(itemID), row.params)
+		} else {
+			var created *podio.Item
+			created, err = r.provider.client.CreateItem(appID, row.params)
+			if created != nil {
+				itemID = created.ItemID
+			}
+		}
+
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to import row %d: %s", row.line, err))
+
+			// Track the items imported so far and the existing items of the
+			// remaining rows. The content hash is left empty so the next
+			// apply imports the file again.
+			for _, rest := range parsed[i:] {
+				if itemID, ok := existing[rest.key]; ok {
+					itemIDs.Elems[rest.key] = types.Int64{Value: int64(itemID)}
+				}
+			}
+
+			data.ContentHash = types.String{Value: ""}
+			data.ItemIDs = itemIDs
+
+			return diags
+		}
+
+		itemIDs.Elems[row.key] = types.Int64{Value: int64(itemID)}
+	}
+
+	tflog.Trace(ctx, "imported items into Podio")
+
+	data.ContentHash = types.String{Value: hash}
+	data.ItemIDs = itemIDs
+
+	return diags
+}
+
+func (r itemImportResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data itemImportResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.importItems(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Items are only written once the whole file is valid. If writing them
+	// failed part way, the partial import is saved so none of them are lost.
+	if resp.Diagnostics.HasError() && data.ItemIDs.Unknown {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read keeps the prior state. Changes to the file are detected when planning,
+// and changes made to the items in Podio are not tracked.
+func (r itemImportResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data itemImportResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r itemImportResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data itemImportResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = r.importItems(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	// Items are only written once the whole file is valid. If writing them
+	// failed part way, the partial import is saved so none of them are lost.
+	if resp.Diagnostics.HasError() && data.ItemIDs.Unknown {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r itemImportResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	resp.State.RemoveResource(ctx)
+}
+
+func (r itemImportResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	resp.Diagnostics.AddError("Import not supported", "podio_item_import can't be imported. Creating it upserts the items of the file, so existing items aren't duplicated.")
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestReadItemImportRowsCSV(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		rows    []map[string]string
+		err     bool
+	}{
+		{
+			name:    "header and rows",
+			content: "Name,Email\nAda,ada@example.com\nGrace,grace@example.com\n",
+			rows: []map[string]string{
+				{"Name": "Ada", "Email": "ada@example.com"},
+				{"Name": "Grace", "Email": "grace@example.com"},
+			},
+		},
+		{
+			name:    "whitespace is trimmed",
+			content: " Name , Email \n Ada ,  ada@example.com \n",
+			rows: []map[string]string{
+				{"Name": "Ada", "Email": "ada@example.com"},
+			},
+		},
+		{
+			name:    "short rows leave columns out",
+			content: "Name,Email\nAda\n",
+			rows: []map[string]string{
+				{"Name": "Ada"},
+			},
+		},
+		{
+			name:    "quoted values",
+			content: "Name,Notes\n\"Lovelace, Ada\",\"said \"\"hi\"\"\"\n",
+			rows: []map[string]string{
+				{"Name": "Lovelace, Ada", "Notes": "said \"hi\""},
+			},
+		},
+		{
+			name:    "header only",
+			content: "Name,Email\n",
+			rows:    []map[string]string{},
+		},
+		{
+			name:    "empty file",
+			content: "",
+			err:     true,
+		},
+		{
+			name:    "unterminated quote",
+			content: "Name\n\"Ada\n",
+			err:     true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "items.csv")
+			if err := os.WriteFile(path, []byte(c.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			rows, err := readItemImportRows(path, "")
+
+			if c.err {
+				if err == nil {
+					t.Fatalf("expected an error, got rows %v", rows)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(rows, c.rows) {
+				t.Errorf("expected rows %v, got %v", c.rows, rows)
+			}
+		})
+	}
+}
+
+func TestReadItemImportRowsXLSX(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "items.xlsx")
+
+	f := excelize.NewFile()
+	if _, err := f.NewSheet("Leads"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, cell := range []struct{ sheet, axis, value string }{
+		{"Sheet1", "A1", "Ignored"},
+		{"Leads", "A1", "Name"},
+		{"Leads", "B1", "Score"},
+		{"Leads", "A2", "Ada"},
+		{"Leads", "B2", "42"},
+	} {
+		if err := f.SetCellValue(cell.sheet, cell.axis, cell.value); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := f.SaveAs(path); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := readItemImportRows(path, "Leads")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []map[string]string{{"Name": "Ada", "Score": "42"}}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected rows %v, got %v", expected, rows)
+	}
+
+	rows, err = readItemImportRows(path, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(rows) != 0 {
+		t.Errorf("expected the first sheet to have no rows, got %v", rows)
+	}
+
+	if _, err := readItemImportRows(path, "Missing"); err == nil {
+		t.Error("expected an error for a missing sheet")
+	}
+}
+
+func TestPlanContentHash(t *testing.T) {
+	priorIDs := types.Map{ElemType: types.Int64Type, Elems: map[string]attr.Value{"a": types.Int64{Value: 1}}}
+	unknownIDs := types.Map{ElemType: types.Int64Type, Unknown: true}
+
+	cases := []struct {
+		name  string
+		prior types.String
+		hash  string
+		ids   types.Map
+	}{
+		{
+			name:  "unchanged file keeps the item IDs",
+			prior: types.String{Value: "abc"},
+			hash:  "abc",
+			ids:   priorIDs,
+		},
+		{
+			name:  "changed file makes the item IDs unknown",
+			prior: types.String{Value: "abc"},
+			hash:  "def",
+			ids:   unknownIDs,
+		},
+		{
+			name:  "failed import is imported again",
+			prior: types.String{Value: ""},
+			hash:  "abc",
+			ids:   unknownIDs,
+		},
+		{
+			name:  "new import",
+			prior: types.String{Null: true},
+			hash:  "abc",
+			ids:   unknownIDs,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data := itemImportResourceData{ItemIDs: priorIDs}
+			data.planContentHash(c.hash, c.prior)
+
+			if !data.ContentHash.Equal(types.String{Value: c.hash}) {
+				t.Errorf("expected content_hash %q, got %v", c.hash, data.ContentHash)
+			}
+
+			if !data.ItemIDs.Equal(c.ids) {
+				t.Errorf("expected item_ids %v, got %v", c.ids, data.ItemIDs)
+			}
+		})
+	}
+}
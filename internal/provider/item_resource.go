@@ -0,0 +1,519 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kayteh/podio-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = itemResourceType{}
+var _ tfsdk.Resource = itemResource{}
+
+// itemFieldValueAttributes are the value attributes used for each supported
+// field type.
+var itemFieldValueAttributes = map[string]string{
+	"text":     "text",
+	"number":   "number",
+	"category": "options",
+	"date":     "start",
+	"app":      "item_ids",
+}
+
+type itemResourceType struct{}
+
+func (t itemResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "An item within an app in Podio, meant for seed and reference data. Items are matched by `external_id`, so an existing item with the same `external_id` is adopted instead of creating a duplicate.",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"app_id": {
+				MarkdownDescription: "ID of the app",
+				Type:                types.Int64Type,
+				Required:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"item_id": {
+				MarkdownDescription: "ID of the item",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"external_id": {
+				MarkdownDescription: "External ID of the item, unique within the app",
+				Type:                types.StringType,
+				Required:            true,
+			},
+			"title": {
+				MarkdownDescription: "Title of the item",
+				Type:                types.StringType,
+				Computed:            true,
+			},
+			"url": {
+				MarkdownDescription: "URL of the item",
+				Type:                types.StringType,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"fields": {
+				MarkdownDescription: "Values of the fields of the item, keyed by the `external_id` of the field. Set the attribute matching the type of the field: `text` for text fields, `number` for number fields, `options` for category fields, `start` and `end` for date fields and `item_ids` for app reference fields. Fields that aren't listed are left alone.",
+				Required:            true,
+				Attributes:          tfsdk.MapNestedAttributes(itemFieldValueSchema(false), tfsdk.MapNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+// itemFieldValueSchema returns the attributes of a field value, either set in
+// the configuration or computed.
+func itemFieldValueSchema(computed bool) map[string]tfsdk.Attribute {
+	return map[string]tfsdk.Attribute{
+		"text": {
+			MarkdownDescription: "Value of a text field",
+			Type:                types.StringType,
+			Optional:            !computed,
+			Computed:            computed,
+		},
+		"number": {
+			MarkdownDescription: "Value of a number field",
+			Type:                types.Float64Type,
+			Optional:            !computed,
+			Computed:            computed,
+		},
+		"options": {
+			MarkdownDescription: "Texts of the selected options of a category field",
+			Type:                types.ListType{ElemType: types.StringType},
+			Optional:            !computed,
+			Computed:            computed,
+		},
+		"start": {
+			MarkdownDescription: "Start of a date field, either `YYYY-MM-DD` or `YYYY-MM-DD HH:MM:SS`",
+			Type:                types.StringType,
+			Optional:            !computed,
+			Computed:            computed,
+		},
+		"end": {
+			MarkdownDescription: "End of a date field, either `YYYY-MM-DD` or `YYYY-MM-DD HH:MM:SS`",
+			Type:                types.StringType,
+			Optional:            !computed,
+			Computed:            computed,
+		},
+		"item_ids": {
+			MarkdownDescription: "IDs of the items referenced by an app reference field",
+			Type:                types.ListType{ElemType: types.Int64Type},
+			Optional:            !computed,
+			Computed:            computed,
+		},
+	}
+}
+
+func (t itemResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return itemResource{
+		provider: provider,
+	}, diags
+}
+
+type itemResourceData struct {
+	AppID      types.Int64                   `tfsdk:"app_id"`
+	ItemID     types.Int64                   `tfsdk:"item_id"`
+	ExternalID types.String                  `tfsdk:"external_id"`
+	Title      types.String                  `tfsdk:"title"`
+	URL        types.String                  `tfsdk:"url"`
+	Fields     map[string]itemFieldValueData `tfsdk:"fields"`
+}
+
+type itemFieldValueData struct {
+	Text    types.String  `tfsdk:"text"`
+	Number  types.Float64 `tfsdk:"number"`
+	Options types.List    `tfsdk:"options"`
+	Start   types.String  `tfsdk:"start"`
+	End     types.String  `tfsdk:"end"`
+	ItemIDs types.List    `tfsdk:"item_ids"`
+}
+
+// newItemFieldValueData converts the values Podio returns for a field of an
+// item. Only the attribute matching the field type is set.
+func newItemFieldValueData(fieldType string, values []map[string]interface{}) itemFieldValueData {
+	data := itemFieldValueData{
+		Text:    types.String{Null: true},
+		Number:  types.Float64{Null: true},
+		Options: types.List{ElemType: types.StringType, Null: true},
+		Start:   types.String{Null: true},
+		End:     types.String{Null: true},
+		ItemIDs: types.List{ElemType: types.Int64Type, Null: true},
+	}
+
+	switch fieldType {
+	case "text":
+		data.Text = types.String{Value: ""}
+		if len(values) > 0 {
+			text, _ := values[0]["value"].(string)
+			data.Text = types.String{Value: text}
+		}
+	case "number":
+		data.Number = types.Float64{Value: 0}
+		if len(values) > 0 {
+			// Podio returns numbers as strings like "12.5000".
+			switch value := values[0]["value"].(type) {
+			case string:
+				number, _ := strconv.ParseFloat(value, 64)
+				data.Number = types.Float64{Value: number}
+			case float64:
+				data.Number = types.Float64{Value: value}
+			}
+		}
+	case "category":
+		data.Options = types.List{ElemType: types.StringType, Elems: []attr.Value{}}
+		for _, value := range values {
+			option, _ := value["value"].(map[string]interface{})
+			text, _ := option["text"].(string)
+			data.Options.Elems = append(data.Options.Elems, types.String{Value: text})
+		}
+	case "date":
+		data.Start = types.String{Value: ""}
+		if len(values) > 0 {
+			start, _ := values[0]["start"].(string)
+			data.Start = types.String{Value: start}
+
+			if end, ok := values[0]["end"].(string); ok && end != "" {
+				data.End = types.String{Value: end}
+			}
+		}
+	case "app":
+		data.ItemIDs = types.List{ElemType: types.Int64Type, Elems: []attr.Value{}}
+		for _, value := range values {
+			item, _ := value["value"].(map[string]interface{})
+			itemID, _ := item["item_id"].(float64)
+			data.ItemIDs.Elems = append(data.ItemIDs.Elems, types.Int64{Value: int64(itemID)})
+		}
+	}
+
+	return data
+}
+
+// itemDate returns a date from Podio in the same precision as the configured
+// date, so dates without a time don't show a difference.
+func itemDate(configured types.String, remote types.String) types.String {
+	if configured.Null {
+		return types.String{Null: true}
+	}
+
+	if len(configured.Value) == len("2006-01-02") && len(remote.Value) > len(configured.Value) {
+		return types.String{Value: remote.Value[:len(configured.Value)]}
+	}
+
+	return remote
+}
+
+// itemParams builds the Podio item from the Terraform data. Category options
+// are resolved by their text against the fields of the app.
+func (data itemResourceData) itemParams(ctx context.Context, appFields []podio.AppField) (podio.CreateItemParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := podio.CreateItemParams{
+		ExternalID: data.ExternalID.Value,
+		Fields:     map[string]interface{}{},
+	}
+
+	fields := map[string]podio.AppField{}
+	for _, field := range sortedAppFields(appFields) {
+		fields[field.ExternalID] = field
+	}
+
+	for externalID, value := range data.Fields {
+		path := tftypes.NewAttributePath().WithAttributeName("fields").WithElementKeyString(externalID)
+
+		field, ok := fields[externalID]
+		if !ok {
+			diags.AddAttributeError(path, "Unknown field", fmt.Sprintf("Field %q doesn't exist in app %d", externalID, data.AppID.Value))
+			continue
+		}
+
+		attribute, ok := itemFieldValueAttributes[field.Type]
+		if !ok {
+			diags.AddAttributeError(path, "Unsupported field type", fmt.Sprintf("Field %q is a %s field, which can't be set by podio_item", externalID, field.Type))
+			continue
+		}
+
+		missing := false
+
+		switch field.Type {
+		case "text":
+			missing = value.Text.Null
+			params.Fields[externalID] = value.Text.Value
+		case "number":
+			missing = value.Number.Null
+			params.Fields[externalID] = value.Number.Value
+		case "category":
+			missing = value.Options.Null
+
+			var texts []string
+			diags.Append(value.Options.ElementsAs(ctx, &texts, true)...)
+
+			optionIDs := map[string]int64{}
+			for _, option := range categoryOptions(field.Config.Settings) {
+				if option.Status != "deleted" {
+					optionIDs[option.Text] = option.ID
+				}
+			}
+
+			ids := []int64{}
+			for _, text := range texts {
+				id, ok := optionIDs[text]
+				if !ok {
+					diags.AddAttributeError(path, "Unknown option", fmt.Sprintf("Field %q has no option %q", externalID, text))
+					continue
+				}
+
+				ids = append(ids, id)
+			}
+
+			params.Fields[externalID] = ids
+		case "date":
+			missing = value.Start.Null
+
+			date := map[string]interface{}{
+				"start": value.Start.Value,
+			}
+
+			if !value.End.Null {
+				date["end"] = value.End.Value
+			}
+
+			params.Fields[externalID] = date
+		case "app":
+			missing = value.ItemIDs.Null
+
+			var itemIDs []int64
+			diags.Append(value.ItemIDs.ElementsAs(ctx, &itemIDs, true)...)
+
+			params.Fields[externalID] = itemIDs
+		}
+
+		if missing {
+			diags.AddAttributeError(path, "Missing field value", fmt.Sprintf("Field %q is a %s field, so `%s` must be set", externalID, field.Type, attribute))
+		}
+	}
+
+	return params, diags
+}
+
+// setItem copies the item returned by Podio into the Terraform data. Only the
+// fields already in the data are refreshed, in the shape they were set.
+func (data *itemResourceData) setItem(item *podio.Item, appFields []podio.AppField) {
+	data.ItemID = types.Int64{Value: int64(item.ItemID)}
+	data.AppID = types.Int64{Value: int64(item.AppID)}
+	data.ExternalID = types.String{Value: item.ExternalID}
+	data.Title = types.String{Value: item.Title}
+	data.URL = types.String{Value: item.Link}
+
+	fieldTypes := map[string]string{}
+	for _, field := range appFields {
+		fieldTypes[field.ExternalID] = field.Type
+	}
+
+	values := map[string][]map[string]interface{}{}
+	for _, field := range item.Fields {
+		values[field.ExternalID] = field.Values
+	}
+
+	fields := map[string]itemFieldValueData{}
+	for externalID, prior := range data.Fields {
+		value := newItemFieldValueData(fieldTypes[externalID], values[externalID])
+
+		if fieldTypes[externalID] == "date" {
+			value.Start = itemDate(prior.Start, value.Start)
+			value.End = itemDate(prior.End, value.End)
+		}
+
+		fields[externalID] = value
+	}
+
+	data.Fields = fields
+}
+
+type itemResource struct {
+	provider provider
+}
+
+func (r itemResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data itemResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := strconv.Itoa(int(data.AppID.Value))
+
+	app, err := r.provider.client.GetApplication(appID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return
+	}
+
+	params, diags := data.itemParams(ctx, app.Fields)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, err := r.provider.client.GetItemByExternalID(appID, data.ExternalID.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get item: %s", err))
+		return
+	}
+
+	if item != nil {
+		_, err = r.provider.client.UpdateItem(strconv.Itoa(item.ItemID), params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update item: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "adopted an existing item in Podio")
+	} else {
+		item, err = r.provider.client.CreateItem(appID, params)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create item: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "created an item in Podio")
+	}
+
+	item, err = r.provider.client.GetItem(strconv.Itoa(item.ItemID))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get item: %s", err))
+		return
+	}
+
+	data.setItem(item, app.Fields)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r itemResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data itemResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	item, err := r.provider.client.GetItem(strconv.Itoa(int(data.ItemID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get item: %s", err))
+		return
+	}
+
+	app, err := r.provider.client.GetApplication(strconv.Itoa(item.AppID))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return
+	}
+
+	if data.Fields == nil {
+		data.Fields = map[string]itemFieldValueData{}
+	}
+
+	data.setItem(item, app.Fields)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r itemResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data itemResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := r.provider.client.GetApplication(strconv.Itoa(int(data.AppID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get app: %s", err))
+		return
+	}
+
+	params, diags := data.itemParams(ctx, app.Fields)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	itemID := strconv.Itoa(int(data.ItemID.Value))
+
+	_, err = r.provider.client.UpdateItem(itemID, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update item: %s", err))
+		return
+	}
+
+	item, err := r.provider.client.GetItem(itemID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get item: %s", err))
+		return
+	}
+
+	data.setItem(item, app.Fields)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r itemResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data itemResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.provider.client.DeleteItem(strconv.Itoa(int(data.ItemID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete item: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r itemResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	itemID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Unable to parse item_id: %s", err))
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("item_id"), itemID)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kayteh/podio-go"
+)
+
+// itemsPageSize is the number of items fetched per request, the maximum
+// Podio allows.
+const itemsPageSize = 500
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = itemsDataSourceType{}
+var _ tfsdk.DataSource = itemsDataSource{}
+
+type itemsDataSourceType struct{}
+
+func (t itemsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "Items within an app in Podio, optionally filtered",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"app_id": {
+				Type:        types.Int64Type,
+				Description: "The numeric ID of the app.",
+				Required:    true,
+			},
+			"view_id": {
+				Type:        types.Int64Type,
+				Description: "Only return the items shown in this view of the app.",
+				Optional:    true,
+			},
+			"external_id": {
+				Type:        types.StringType,
+				Description: "Only return the item with this external ID. Mutually exclusive with the other filters.",
+				Optional:    true,
+			},
+			"created_by": {
+				Type:        types.ListType{ElemType: types.Int64Type},
+				Description: "Only return items created by any of these user IDs.",
+				Optional:    true,
+			},
+			"category_filters": {
+				Type:        types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+				Description: "Only return items with any of the given option texts selected, keyed by the external ID of the category field.",
+				Optional:    true,
+			},
+			"date_filters": {
+				Description: "Only return items with a date within the range, keyed by the external ID of the date field.",
+				Optional:    true,
+				Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+					"from": {
+						Description: "Start of the range, `YYYY-MM-DD`",
+						Type:        types.StringType,
+						Optional:    true,
+					},
+					"to": {
+						Description: "End of the range, `YYYY-MM-DD`",
+						Type:        types.StringType,
+						Optional:    true,
+					},
+				}, tfsdk.MapNestedAttributesOptions{}),
+			},
+			"items": {
+				Description: "Items matching the filters",
+				Computed:    true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"item_id": {
+						Description: "ID of the item",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"external_id": {
+						Description: "External ID of the item",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"title": {
+						Description: "Title of the item",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"url": {
+						Description: "URL of the item",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"fields": {
+						Description: "Values of the text, number, category, date and app reference fields of the item, keyed by the external ID of the field",
+						Computed:    true,
+						Attributes:  tfsdk.MapNestedAttributes(itemFieldValueSchema(true), tfsdk.MapNestedAttributesOptions{}),
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t itemsDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return itemsDataSource{
+		provider: provider,
+	}, diags
+}
+
+type itemsDataSourceData struct {
+	AppID           types.Int64                `tfsdk:"app_id"`
+	ViewID          types.Int64                `tfsdk:"view_id"`
+	ExternalID      types.String               `tfsdk:"external_id"`
+	CreatedBy       types.List                 `tfsdk:"created_by"`
+	CategoryFilters types.Map                  `tfsdk:"category_filters"`
+	DateFilters     map[string]itemsDateFilter `tfsdk:"date_filters"`
+	Items           []itemsDataSourceItem      `tfsdk:"items"`
+}
+
+type itemsDateFilter struct {
+	From types.String `tfsdk:"from"`
+	To   types.String `tfsdk:"to"`
+}
+
+type itemsDataSourceItem struct {
+	ItemID     types.Int64                   `tfsdk:"item_id"`
+	ExternalID types.String                  `tfsdk:"external_id"`
+	Title      types.String                  `tfsdk:"title"`
+	URL        types.String                  `tfsdk:"url"`
+	Fields     map[string]itemFieldValueData `tfsdk:"fields"`
+}
+
+func newItemsDataSourceItem(item podio.Item) itemsDataSourceItem {
+	fields := map[string]itemFieldValueData{}
+	for _, field := range item.Fields {
+		fields[field.ExternalID] = newItemFieldValueData(field.Type, field.Values)
+	}
+
+	return itemsDataSourceItem{
+		ItemID:     types.Int64{Value: int64(item.ItemID)},
+		ExternalID: types.String{Value: item.ExternalID},
+		Title:      types.String{Value: item.Title},
+		URL:        types.String{Value: item.Link},
+		Fields:     fields,
+	}
+}
+
+// filters builds the Podio filters from the Terraform data, resolving field
+// external IDs and option texts against the fields of the app.
+func (data itemsDataSourceData) filters(ctx context.Context, appFields []podio.AppField) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filters := map[string]interface{}{}
+
+	fields := map[string]podio.AppField{}
+	for _, field := range sortedAppFields(appFields) {
+		fields[field.ExternalID] = field
+	}
+
+	if !data.CreatedBy.Null {
+		var userIDs []int64
+		diags.Append(data.CreatedBy.ElementsAs(ctx, &userIDs, false)...)
+
+		users := []interface{}{}
+		for _, userID := range userIDs {
+			users = append(users, map[string]interface{}{
+				"type": "user",
+				"id":   userID,
+			})
+		}
+
+		filters["created_by"] = users
+	}
+
+	categories := map[string][]string{}
+	diags.Append(data.CategoryFilters.ElementsAs(ctx, &categories, true)...)
+
+	for externalID, texts := range categories {
+		field, ok := fields[externalID]
+		if !ok || field.Type != "category" {
+			diags.AddError("Unknown field", fmt.Sprintf("Category field %q doesn't exist in app %d", externalID, data.AppID.Value))
+			continue
+		}
+
+		optionIDs := map[string]int64{}
+		for _, option := range categoryOptions(field.Config.Settings) {
+			optionIDs[option.Text] = option.ID
+		}
+
+		ids := []int64{}
+		for _, text := range texts {
+			id, ok := optionIDs[text]
+			if !ok {
+				diags.AddError("Unknown option", fmt.Sprintf("Field %q has no option %q", externalID, text))
+				continue
+			}
+
+			ids = append(ids, id)
+		}
+
+		filters[fmt.Sprintf("%d", field.FieldID)] = ids
+	}
+
+	for externalID, date := range data.DateFilters {
+		field, ok := fields[externalID]
+		if !ok || field.Type != "date" {
+			diags.AddError("Unknown field", fmt.Sprintf("Date field %q doesn't exist in app %d", externalID, data.AppID.Value))
+			continue
+		}
+
+		filter := map[string]interface{}{}
+		if !date.From.Null {
+			filter["from"] = date.From.Value
+		}
+
+		if !date.To.Null {
+			filter["to"] = date.To.Value
+		}
+
+		filters[fmt.Sprintf("%d", field.FieldID)] = filter
+	}
+
+	return filters, diags
+}
+
+type itemsDataSource struct {
+	provider provider
+}
+
+func (d itemsDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data itemsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appID := fmt.Sprintf("%d", data.AppID.Value)
+	data.Items = []itemsDataSourceItem{}
+
+	if !data.ExternalID.Null {
+		if !data.ViewID.Null || !data.CreatedBy.Null || !data.CategoryFilters.Null || data.DateFilters != nil {
+			resp.Diagnostics.AddError("Ambiguous search pattern", "`external_id` can't be combined with other filters.")
+			return
+		}
+
+		item, err := d.provider.client.GetItemByExternalID(appID, data.ExternalID.Value)
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching item", fmt.Sprintf("Unable to fetch item, got error: %s", err))
+			return
+		}
+
+		if item != nil {
+			data.Items = append(data.Items, newItemsDataSourceItem(*item))
+		}
+
+		diags = resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	app, err := d.provider.client.GetApplication(appID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching app", fmt.Sprintf("Unable to fetch app, got error: %s", err))
+		return
+	}
+
+	filters, diags := data.filters(ctx, app.Fields)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := podio.FilterItemsParams{
+		Filters: filters,
+	}
+
+	if !data.ViewID.Null {
+		params.ViewID = fmt.Sprintf("%d", data.ViewID.Value)
+	}
+
+	items, err := filterAllItems(d.provider.client, appID, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching items", fmt.Sprintf("Unable to fetch items, got error: %s", err))
+		return
+	}
+
+	for _, item := range items {
+		data.Items = append(data.Items, newItemsDataSourceItem(item))
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// filterAllItems fetches all items matching the filters, one page at a time.
+func filterAllItems(client *podio.Client, appID string, params podio.FilterItemsParams) ([]podio.Item, error) {
+	items := []podio.Item{}
+
+	params.Limit = itemsPageSize
+	params.Offset = 0
+
+	for {
+		result, err := client.FilterItems(appID, params)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, result.Items...)
+		params.Offset += len(result.Items)
+
+		if len(result.Items) < itemsPageSize || params.Offset >= result.Filtered {
+			return items, nil
+		}
+	}
+}
@@ -3,7 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,19 +26,98 @@ func (t organizationDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema
 		Attributes: map[string]tfsdk.Attribute{
 			"url_label": {
 				Type:        types.StringType,
-				Description: "The URL label/slug for the organization, e.g. the `citrix` part of `https://podio.com/citrix`. Mutually exclusive with `org_id`.",
+				Description: "The URL label/slug for the organization, e.g. the `citrix` part of `https://podio.com/citrix`. Mutually exclusive with `org_id`, `name` and `name_regex`.",
 				Optional:    true,
+				Computed:    true,
 			},
 			"org_id": {
 				Type:        types.Int64Type,
-				Description: "The numeric ID of the organization. Mutually exclusive with `url_label`.",
+				Description: "The numeric ID of the organization. Mutually exclusive with `url_label`, `name` and `name_regex`.",
 				Optional:    true,
+				Computed:    true,
 			},
 			"url": {
 				Description: "URL of the Podio organization",
 				Type:        types.StringType,
 				Computed:    true,
 			},
+			"name": {
+				Description: "Name of the organization. When set, the organization is looked up by its exact name among the organizations of the user. Mutually exclusive with `url_label`, `org_id` and `name_regex`.",
+				Type:        types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"name_regex": {
+				Description: "Regular expression matched against the names of the organizations of the user. Exactly one organization must match. Mutually exclusive with `url_label`, `org_id` and `name`.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"logo": {
+				Description: "URL of the logo of the organization",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"type": {
+				Description: "Type of the organization, either `free`, `sponsored` or `premium`",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"premium": {
+				Description: "True if the organization has a premium subscription",
+				Type:        types.BoolType,
+				Computed:    true,
+			},
+			"tier": {
+				Description: "Subscription tier of the organization",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"segment": {
+				Description: "Market segment of the organization",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"member_count": {
+				Description: "Number of members of the organization",
+				Type:        types.Int64Type,
+				Computed:    true,
+			},
+			"created_on": {
+				Description: "Date and time the organization was created",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"role": {
+				Description: "Role of the authenticated user in the organization, either `regular` or `admin`",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"rights": {
+				Description: "Rights of the authenticated user on the organization, e.g. `add_space`",
+				Type:        types.SetType{ElemType: types.StringType},
+				Computed:    true,
+			},
+			"sales_agent": {
+				Description: "Podio sales agent of the organization, if any",
+				Computed:    true,
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"name": {
+						Description: "Name of the sales agent",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"email": {
+						Description: "Email address of the sales agent",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"phone": {
+						Description: "Phone number of the sales agent",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
 		},
 	}, nil
 }
@@ -49,9 +131,58 @@ func (t organizationDataSourceType) NewDataSource(ctx context.Context, in tfsdk.
 }
 
 type organizationDataSourceData struct {
-	URLLabel types.String `tfsdk:"url_label"`
-	OrgID    types.Int64  `tfsdk:"org_id"`
-	URL      types.String `tfsdk:"url"`
+	URLLabel    types.String                `tfsdk:"url_label"`
+	OrgID       types.Int64                 `tfsdk:"org_id"`
+	URL         types.String                `tfsdk:"url"`
+	Name        types.String                `tfsdk:"name"`
+	NameRegex   types.String                `tfsdk:"name_regex"`
+	Logo        types.String                `tfsdk:"logo"`
+	Type        types.String                `tfsdk:"type"`
+	Premium     types.Bool                  `tfsdk:"premium"`
+	Tier        types.String                `tfsdk:"tier"`
+	Segment     types.String                `tfsdk:"segment"`
+	MemberCount types.Int64                 `tfsdk:"member_count"`
+	CreatedOn   types.String                `tfsdk:"created_on"`
+	Role        types.String                `tfsdk:"role"`
+	Rights      types.Set                   `tfsdk:"rights"`
+	SalesAgent  *organizationSalesAgentData `tfsdk:"sales_agent"`
+}
+
+type organizationSalesAgentData struct {
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+	Phone types.String `tfsdk:"phone"`
+}
+
+// setOrganization copies the organization returned by Podio into the
+// Terraform data.
+func (data *organizationDataSourceData) setOrganization(org *podio.Organization) {
+	data.URL = types.String{Value: org.URL}
+	data.URLLabel = types.String{Value: org.URLLabel}
+	data.OrgID = types.Int64{Value: int64(org.ID)}
+	data.Name = types.String{Value: org.Name}
+	data.Logo = types.String{Value: org.Logo}
+	data.Type = types.String{Value: org.Type}
+	data.Premium = types.Bool{Value: org.Premium}
+	data.Tier = types.String{Value: org.Tier}
+	data.Segment = types.String{Value: org.Segment}
+	data.MemberCount = types.Int64{Value: int64(org.MemberCount)}
+	data.CreatedOn = types.String{Value: org.CreatedOn}
+	data.Role = types.String{Value: org.Role}
+
+	data.Rights = types.Set{ElemType: types.StringType, Elems: []attr.Value{}}
+	for _, right := range org.Rights {
+		data.Rights.Elems = append(data.Rights.Elems, types.String{Value: right})
+	}
+
+	data.SalesAgent = nil
+	if org.SalesAgent != nil {
+		data.SalesAgent = &organizationSalesAgentData{
+			Name:  types.String{Value: org.SalesAgent.Name},
+			Email: types.String{Value: org.SalesAgent.Email},
+			Phone: types.String{Value: org.SalesAgent.Phone},
+		}
+	}
 }
 
 type organizationDataSource struct {
@@ -71,9 +202,16 @@ func (d organizationDataSource) Read(ctx context.Context, req tfsdk.ReadDataSour
 	org := &podio.Organization{}
 	var err error
 
-	// Error if both `url_label` and `org_id` are set
-	if !data.URLLabel.Null && !data.OrgID.Null {
-		resp.Diagnostics.AddError("Ambiguous search pattern", "Only set one of `url_label` or `org_id`, not both.")
+	// Error if more than one of `url_label`, `org_id`, `name` and `name_regex` are set
+	set := 0
+	for _, null := range []bool{data.URLLabel.Null, data.OrgID.Null, data.Name.Null, data.NameRegex.Null} {
+		if !null {
+			set++
+		}
+	}
+
+	if set > 1 {
+		resp.Diagnostics.AddError("Ambiguous search pattern", "Only set one of `url_label`, `org_id`, `name` or `name_regex`.")
 		return
 	}
 
@@ -81,8 +219,20 @@ func (d organizationDataSource) Read(ctx context.Context, req tfsdk.ReadDataSour
 		org, err = d.provider.client.GetOrganizationBySlug(data.URLLabel.Value)
 	} else if !data.OrgID.Null {
 		org, err = d.provider.client.GetOrganization(fmt.Sprintf("%d", data.OrgID.Value))
+	} else if !data.Name.Null || !data.NameRegex.Null {
+		var diags diag.Diagnostics
+		org, diags = d.findOrganizationByName(data)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The list of organizations doesn't include all details, so fetch
+		// the match again by its ID.
+		org, err = d.provider.client.GetOrganization(fmt.Sprintf("%d", org.ID))
 	} else {
-		resp.Diagnostics.AddError("No URL or Org ID specified", "Either `url_label` or `org_id` must be specified")
+		resp.Diagnostics.AddError("No URL, Org ID or name specified", "One of `url_label`, `org_id`, `name` or `name_regex` must be specified")
 		return
 	}
 
@@ -91,10 +241,57 @@ func (d organizationDataSource) Read(ctx context.Context, req tfsdk.ReadDataSour
 		return
 	}
 
-	data.URL = types.String{Value: org.URL}
-	data.URLLabel = types.String{Value: org.URLLabel}
-	data.OrgID = types.Int64{Value: int64(org.ID)}
+	data.setOrganization(org)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
+
+// findOrganizationByName searches the organizations of the user for the one
+// whose name equals `name` or matches `name_regex`.
+func (d organizationDataSource) findOrganizationByName(data organizationDataSourceData) (*podio.Organization, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	match := func(name string) bool { return name == data.Name.Value }
+	pattern := fmt.Sprintf("name %q", data.Name.Value)
+
+	if !data.NameRegex.Null {
+		re, err := regexp.Compile(data.NameRegex.Value)
+		if err != nil {
+			diags.AddError("Invalid name_regex", fmt.Sprintf("Unable to parse `name_regex`, got error: %s", err))
+			return nil, diags
+		}
+
+		match = re.MatchString
+		pattern = fmt.Sprintf("name_regex %q", data.NameRegex.Value)
+	}
+
+	orgs, err := d.provider.client.GetOrganizations()
+	if err != nil {
+		diags.AddError("Error fetching organizations", fmt.Sprintf("Unable to fetch organizations, got error: %s", err))
+		return nil, diags
+	}
+
+	matches := []podio.Organization{}
+	for _, org := range orgs {
+		if match(org.Name) {
+			matches = append(matches, org)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError("No organization found", fmt.Sprintf("No organization of the user matches %s", pattern))
+		return nil, diags
+	case 1:
+		return &matches[0], diags
+	}
+
+	candidates := []string{}
+	for _, org := range matches {
+		candidates = append(candidates, fmt.Sprintf("%s (org_id %d, url_label %q)", org.Name, org.ID, org.URLLabel))
+	}
+
+	diags.AddError("Ambiguous match", fmt.Sprintf("%d organizations match %s, use `org_id` or `url_label` instead:\n  %s", len(matches), pattern, strings.Join(candidates, "\n  ")))
+	return nil, diags
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.DataSourceType = organizationsDataSourceType{}
+var _ tfsdk.DataSource = organizationsDataSource{}
+
+type organizationsDataSourceType struct{}
+
+func (t organizationsDataSourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "All Podio organizations the authenticated user is a member of",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"include_spaces": {
+				Type:        types.BoolType,
+				Description: "Also return the spaces of each organization visible to the user. Defaults to `false`.",
+				Optional:    true,
+			},
+			"organizations": {
+				Description: "Organizations of the user",
+				Computed:    true,
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"org_id": {
+						Description: "ID of the organization",
+						Type:        types.Int64Type,
+						Computed:    true,
+					},
+					"name": {
+						Description: "Name of the organization",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"url_label": {
+						Description: "The URL label/slug of the organization",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"url": {
+						Description: "URL of the organization",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"role": {
+						Description: "Role of the authenticated user in the organization, either `regular` or `admin`",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"spaces": {
+						Description: "Spaces of the organization visible to the user, only set if `include_spaces` is true",
+						Computed:    true,
+						Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+							"space_id": {
+								Description: "ID of the space",
+								Type:        types.Int64Type,
+								Computed:    true,
+							},
+							"name": {
+								Description: "Name of the space",
+								Type:        types.StringType,
+								Computed:    true,
+							},
+							"url": {
+								Description: "URL of the space",
+								Type:        types.StringType,
+								Computed:    true,
+							},
+							"privacy": {
+								Description: "Privacy of the space, either `open` or `closed`",
+								Type:        types.StringType,
+								Computed:    true,
+							},
+						}, tfsdk.ListNestedAttributesOptions{}),
+					},
+				}, tfsdk.ListNestedAttributesOptions{}),
+			},
+		},
+	}, nil
+}
+
+func (t organizationsDataSourceType) NewDataSource(ctx context.Context, in tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return organizationsDataSource{
+		provider: provider,
+	}, diags
+}
+
+type organizationsDataSourceData struct {
+	IncludeSpaces types.Bool                   `tfsdk:"include_spaces"`
+	Organizations []organizationsDataSourceOrg `tfsdk:"organizations"`
+}
+
+type organizationsDataSourceOrg struct {
+	OrgID    types.Int64                    `tfsdk:"org_id"`
+	Name     types.String                   `tfsdk:"name"`
+	URLLabel types.String                   `tfsdk:"url_label"`
+	URL      types.String                   `tfsdk:"url"`
+	Role     types.String                   `tfsdk:"role"`
+	Spaces   []organizationsDataSourceSpace `tfsdk:"spaces"`
+}
+
+type organizationsDataSourceSpace struct {
+	SpaceID types.Int64  `tfsdk:"space_id"`
+	Name    types.String `tfsdk:"name"`
+	URL     types.String `tfsdk:"url"`
+	Privacy types.String `tfsdk:"privacy"`
+}
+
+type organizationsDataSource struct {
+	provider provider
+}
+
+func (d organizationsDataSource) Read(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+	var data organizationsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgs, err := d.provider.client.GetOrganizations()
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching organizations", fmt.Sprintf("Unable to fetch organizations, got error: %s", err))
+		return
+	}
+
+	data.Organizations = []organizationsDataSourceOrg{}
+	for _, org := range orgs {
+		o := organizationsDataSourceOrg{
+			OrgID:    types.Int64{Value: int64(org.ID)},
+			Name:     types.String{Value: org.Name},
+			URLLabel: types.String{Value: org.URLLabel},
+			URL:      types.String{Value: org.URL},
+			Role:     types.String{Value: org.Role},
+		}
+
+		if data.IncludeSpaces.Value {
+			o.Spaces = []organizationsDataSourceSpace{}
+			for _, space := range org.Spaces {
+				o.Spaces = append(o.Spaces, organizationsDataSourceSpace{
+					SpaceID: types.Int64{Value: int64(space.ID)},
+					Name:    types.String{Value: space.Name},
+					URL:     types.String{Value: space.URL},
+					Privacy: types.String{Value: space.Privacy},
+				})
+			}
+		}
+
+		data.Organizations = append(data.Organizations, o)
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
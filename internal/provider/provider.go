@@ -78,15 +78,31 @@ func (p *provider) Configure(ctx context.Context, req tfsdk.ConfigureProviderReq
 
 func (p *provider) GetResources(ctx context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
 	return map[string]tfsdk.ResourceType{
-		"podio_space":     spaceResourceType{},
-		"podio_app":       appResourceType{},
-		"podio_app_field": appFieldResourceType{},
+		"podio_space":             spaceResourceType{},
+		"podio_app":               appResourceType{},
+		"podio_app_field":         appFieldResourceType{},
+		"podio_app_field_order":   appFieldOrderResourceType{},
+		"podio_app_form":          appFormResourceType{},
+		"podio_app_from_template": appFromTemplateResourceType{},
+		"podio_app_view":          appViewResourceType{},
+		"podio_hook":              hookResourceType{},
+		"podio_grant":             grantResourceType{},
+		"podio_item":              itemResourceType{},
+		"podio_item_import":       itemImportResourceType{},
+		"podio_task":              taskResourceType{},
 	}, nil
 }
 
 func (p *provider) GetDataSources(ctx context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
 	return map[string]tfsdk.DataSourceType{
-		"podio_organization": organizationDataSourceType{},
+		"podio_organization":  organizationDataSourceType{},
+		"podio_organizations": organizationsDataSourceType{},
+		"podio_icon_search":   iconSearchDataSourceType{},
+		"podio_app":           appDataSourceType{},
+		"podio_apps":          appsDataSourceType{},
+		"podio_app_fields":    appFieldsDataSourceType{},
+		"podio_app_template":  appTemplateDataSourceType{},
+		"podio_items":         itemsDataSourceType{},
 	}, nil
 }
 
@@ -0,0 +1,398 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kayteh/podio-go"
+	"github.com/kayteh/terraform-provider-podio/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ tfsdk.ResourceType = taskResourceType{}
+var _ tfsdk.Resource = taskResource{}
+var _ tfsdk.ResourceWithValidateConfig = taskResource{}
+var _ tfsdk.ResourceWithModifyPlan = taskResource{}
+
+type taskResourceType struct{}
+
+func (t taskResourceType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		MarkdownDescription: "A task in Podio, optionally attached to a space or item",
+
+		Attributes: map[string]tfsdk.Attribute{
+			"task_id": {
+				MarkdownDescription: "ID of the task",
+				Type:                types.Int64Type,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"text": {
+				MarkdownDescription: "Text of the task",
+				Type:                types.StringType,
+				Required:            true,
+			},
+			"description": {
+				MarkdownDescription: "Description of the task",
+				Type:                types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"due_date": {
+				MarkdownDescription: "Date the task is due, `YYYY-MM-DD`",
+				Type:                types.StringType,
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringMatchesRegexpValidator{
+						Regexp: regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`),
+					},
+				},
+			},
+			"due_time": {
+				MarkdownDescription: "Time of day the task is due, `HH:MM`. Requires `due_date`.",
+				Type:                types.StringType,
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringMatchesRegexpValidator{
+						Regexp: regexp.MustCompile(`^\d{2}:\d{2}$`),
+					},
+				},
+			},
+			"responsible": {
+				MarkdownDescription: "ID of the user responsible for the task. Defaults to the authenticated user.",
+				Type:                types.Int64Type,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"private": {
+				MarkdownDescription: "True if the task is only visible to its creator and the responsible user",
+				Type:                types.BoolType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"labels": {
+				MarkdownDescription: "Labels of the task",
+				Type:                types.SetType{ElemType: types.StringType},
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"ref_type": {
+				MarkdownDescription: "Type of the object the task is attached to. One of: `space`, `item`",
+				Type:                types.StringType,
+				Optional:            true,
+				Validators: []tfsdk.AttributeValidator{
+					validators.StringInSliceValidator{"space", "item"},
+				},
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"ref_id": {
+				MarkdownDescription: "ID of the object the task is attached to",
+				Type:                types.Int64Type,
+				Optional:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.RequiresReplace(),
+				},
+			},
+			"reopen_on_drift": {
+				MarkdownDescription: "If true, a task that has been completed is reopened on the next apply",
+				Type:                types.BoolType,
+				Optional:            true,
+			},
+			"status": {
+				MarkdownDescription: "Status of the task, either `active` or `completed`",
+				Type:                types.StringType,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+			"url": {
+				MarkdownDescription: "URL of the task",
+				Type:                types.StringType,
+				Computed:            true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					tfsdk.UseStateForUnknown(),
+				},
+			},
+		},
+	}, nil
+}
+
+func (t taskResourceType) NewResource(ctx context.Context, in tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	provider, diags := convertProviderType(in)
+
+	return taskResource{
+		provider: provider,
+	}, diags
+}
+
+type taskResourceData struct {
+	TaskID        types.Int64  `tfsdk:"task_id"`
+	Text          types.String `tfsdk:"text"`
+	Description   types.String `tfsdk:"description"`
+	DueDate       types.String `tfsdk:"due_date"`
+	DueTime       types.String `tfsdk:"due_time"`
+	Responsible   types.Int64  `tfsdk:"responsible"`
+	Private       types.Bool   `tfsdk:"private"`
+	Labels        types.Set    `tfsdk:"labels"`
+	RefType       types.String `tfsdk:"ref_type"`
+	RefID         types.Int64  `tfsdk:"ref_id"`
+	ReopenOnDrift types.Bool   `tfsdk:"reopen_on_drift"`
+	Status        types.String `tfsdk:"status"`
+	URL           types.String `tfsdk:"url"`
+}
+
+// taskParams builds the Podio task from the Terraform data.
+func (data taskResourceData) taskParams(ctx context.Context) (podio.CreateTaskParams, diag.Diagnostics) {
+	params := podio.CreateTaskParams{
+		Text:        data.Text.Value,
+		Description: data.Description.Value,
+		DueDate:     data.DueDate.Value,
+		DueTime:     data.DueTime.Value,
+		Responsible: int(data.Responsible.Value),
+		Private:     data.Private.Value,
+		Labels:      []string{},
+		RefType:     data.RefType.Value,
+		RefID:       int(data.RefID.Value),
+	}
+
+	diags := data.Labels.ElementsAs(ctx, &params.Labels, true)
+
+	return params, diags
+}
+
+// setTask copies the task returned by Podio into the Terraform data. The due
+// date and time are only tracked when they were configured.
+func (data *taskResourceData) setTask(task *podio.Task) {
+	data.TaskID = types.Int64{Value: int64(task.TaskID)}
+	data.Text = types.String{Value: task.Text}
+	data.Description = types.String{Value: task.Description}
+	data.Responsible = types.Int64{Value: int64(task.ResponsibleID)}
+	data.Private = types.Bool{Value: task.Private}
+	data.Status = types.String{Value: task.Status}
+	data.URL = types.String{Value: task.Link}
+
+	if !data.DueDate.Null || task.DueDate != "" {
+		data.DueDate = types.String{Value: task.DueDate}
+	}
+
+	if !data.DueTime.Null || task.DueTime != "" {
+		data.DueTime = types.String{Value: task.DueTime}
+	}
+
+	if task.RefType != "" {
+		data.RefType = types.String{Value: task.RefType}
+		data.RefID = types.Int64{Value: int64(task.RefID)}
+	}
+
+	data.Labels = types.Set{ElemType: types.StringType, Elems: []attr.Value{}}
+	for _, label := range task.Labels {
+		data.Labels.Elems = append(data.Labels.Elems, types.String{Value: label})
+	}
+}
+
+type taskResource struct {
+	provider provider
+}
+
+func (r taskResource) ValidateConfig(ctx context.Context, req tfsdk.ValidateResourceConfigRequest, resp *tfsdk.ValidateResourceConfigResponse) {
+	var data taskResourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RefType.Null != data.RefID.Null {
+		resp.Diagnostics.AddError("Incomplete task reference", "`ref_type` and `ref_id` must be set together.")
+	}
+
+	if !data.DueTime.Null && data.DueDate.Null {
+		resp.Diagnostics.AddAttributeError(
+			tftypes.NewAttributePath().WithAttributeName("due_time"),
+			"Missing due_date",
+			"`due_time` can only be set together with `due_date`.",
+		)
+	}
+}
+
+// ModifyPlan plans to reopen a completed task when reopen_on_drift is set.
+func (r taskResource) ModifyPlan(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan taskResourceData
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	var state taskResourceData
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ReopenOnDrift.Value && state.Status.Value == "completed" {
+		diags = resp.Plan.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("status"), "active")
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+func (r taskResource) Create(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+	var data taskResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.taskParams(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.provider.client.CreateTask(params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create task: %s", err))
+		return
+	}
+
+	data.setTask(task)
+
+	tflog.Trace(ctx, "created a task in Podio")
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r taskResource) Read(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+	var data taskResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task, err := r.provider.client.GetTask(strconv.Itoa(int(data.TaskID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get task: %s", err))
+		return
+	}
+
+	data.setTask(task)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r taskResource) Update(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+	var data taskResourceData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	var state taskResourceData
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, diags := data.taskParams(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	taskID := strconv.Itoa(int(data.TaskID.Value))
+
+	if data.Status.Value == "active" && state.Status.Value == "completed" {
+		err := r.provider.client.IncompleteTask(taskID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reopen task: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "reopened a task in Podio")
+	}
+
+	task, err := r.provider.client.UpdateTask(taskID, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update task: %s", err))
+		return
+	}
+
+	data.setTask(task)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r taskResource) Delete(ctx context.Context, req tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
+	var data taskResourceData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.provider.client.DeleteTask(strconv.Itoa(int(data.TaskID.Value)))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete task: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r taskResource) ImportState(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+	taskID, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Unable to parse task_id: %s", err))
+		return
+	}
+
+	diags := resp.State.SetAttribute(ctx, tftypes.NewAttributePath().WithAttributeName("task_id"), taskID)
+	resp.Diagnostics.Append(diags...)
+}
@@ -2,6 +2,7 @@ package validators
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
@@ -75,3 +76,32 @@ func (v StringMatchesRegexpValidator) Validate(ctx context.Context, req tfsdk.Va
 
 	return
 }
+
+var _ tfsdk.AttributeValidator = StringIsJSONValidator{}
+
+type StringIsJSONValidator struct{}
+
+func (v StringIsJSONValidator) Description(ctx context.Context) string {
+	return "must be a valid JSON document"
+}
+
+func (v StringIsJSONValidator) MarkdownDescription(ctx context.Context) string {
+	return "must be a valid JSON document"
+}
+
+func (v StringIsJSONValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var attr types.String
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &attr)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if attr.Null || attr.Unknown {
+		return
+	}
+
+	if !json.Valid([]byte(attr.Value)) {
+		resp.Diagnostics.AddAttributeError(req.AttributePath, "Invalid attribute value", "must be a valid JSON document")
+	}
+}